@@ -0,0 +1,133 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+var (
+	chebTolerance = 1e-13
+	chebMaxDegree = 4096
+)
+
+// chebCoefficients returns the ascending Chebyshev coefficients of the degree n polynomial
+// interpolating f at the n + 1 Chebyshev points cos(j pi / n) mapped onto [a, b], together with
+// the largest absolute value of f at those points.
+//
+// Panics if f is not finite at a sample point.
+func chebCoefficients(f func(float64) float64, a, b float64, n int) ([]float64, float64) {
+
+	vals := make([]float64, n+1)
+	vscale := 0.0
+
+	for j := 0; j <= n; j++ {
+		t := math.Cos(float64(j) * math.Pi / float64(n))
+		x := ((b-a)*t + a + b) / 2
+
+		vals[j] = f(x)
+		if math.IsNaN(vals[j]) || math.IsInf(vals[j], 0) {
+			log.Panicf("NewChebPolyFromFunc: f(%f) = %f.", x, vals[j])
+		}
+
+		vscale = math.Max(vscale, math.Abs(vals[j]))
+	}
+
+	// Discrete cosine transform (type I), with the endpoint terms halved.
+	coef := make([]float64, n+1)
+
+	for k := 0; k <= n; k++ {
+		sum := (vals[0] + vals[n]*math.Cos(float64(k)*math.Pi)) / 2
+		for j := 1; j < n; j++ {
+			sum += vals[j] * math.Cos(float64(j*k%(2*n))*math.Pi/float64(n))
+		}
+
+		coef[k] = 2 * sum / float64(n)
+	}
+
+	coef[0] /= 2
+	coef[n] /= 2
+
+	return coef, vscale
+}
+
+// NewChebPolyFromFunc returns a polynomial approximation p of f on [a, b] in the Chebyshev basis.
+//
+// f is interpolated at Chebyshev points on grids of increasing size, 17, 33, 65, ..., until the
+// trailing Chebyshev coefficients have decayed below the tolerance set by SetChebTolerance()
+// (relative to the largest sampled value of f). The negligible tail is then dropped, so the degree
+// of p is chosen automatically. For smooth (analytic) f, p agrees with f on [a, b] to about the
+// tolerance.
+//
+// The roots of f may then be found with a Solver through ToPoly(), or see NewPolyFromFunc().
+//
+// Panics if a >= b, if f is not finite at a sample point, or if the coefficients have not decayed
+// by the degree set by SetChebMaxDegree().
+func NewChebPolyFromFunc(f func(float64) float64, a, b float64) ChebPoly {
+
+	if !(a < b) {
+		log.Panicf("NewChebPolyFromFunc: invalid interval [%f, %f].", a, b)
+	}
+
+	for n := 16; ; n *= 2 {
+
+		if n > chebMaxDegree {
+			n = chebMaxDegree
+		}
+
+		coef, vscale := chebCoefficients(f, a, b, n)
+
+		// The zero function.
+		if vscale == 0 {
+			return newChebPolyNoReverse([]float64{0}, a, b)
+		}
+
+		cutoff := n
+		for cutoff > 0 && math.Abs(coef[cutoff]) <= chebTolerance*vscale {
+			cutoff--
+		}
+
+		// Accept once a tail of at least n / 8 coefficients is negligible.
+		if n-cutoff >= n/8 && n-cutoff >= 2 {
+			return newChebPolyNoReverse(coef[:cutoff+1], a, b)
+		}
+
+		if n == chebMaxDegree {
+			log.Panicf("NewChebPolyFromFunc: no convergence by degree %d.", chebMaxDegree)
+		}
+	}
+}
+
+// NewPolyFromFunc returns a polynomial approximation p of f on [a, b] in the monomial basis.
+//
+// This is just NewChebPolyFromFunc(f, a, b).ToPoly(). As the conversion to the monomial basis
+// becomes ill-conditioned for high degree, prefer working with the ChebPoly directly when f
+// requires many terms.
+//
+// Panics under the same conditions as NewChebPolyFromFunc().
+func NewPolyFromFunc(f func(float64) float64, a, b float64) Poly {
+
+	return NewChebPolyFromFunc(f, a, b).ToPoly()
+}
+
+// SetChebTolerance sets the relative tolerance at which NewChebPolyFromFunc() considers Chebyshev
+// coefficients negligible to v.
+//
+// Panics for negative v.
+func SetChebTolerance(v float64) {
+	if v < 0 {
+		log.Panic("SetChebTolerance: negative v.")
+	}
+
+	chebTolerance = v
+}
+
+// SetChebMaxDegree sets the largest degree that NewChebPolyFromFunc() will try to n.
+//
+// Panics for n < 16.
+func SetChebMaxDegree(n int) {
+	if n < 16 {
+		log.Panic("SetChebMaxDegree: n less than 16.")
+	}
+
+	chebMaxDegree = n
+}
@@ -0,0 +1,76 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in approx.go.
+*/
+
+func Test_NewChebPolyFromFuncPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewChebPolyFromFunc(math.Exp, 1, 1) })
+	assert.Panics(t, func() { NewChebPolyFromFunc(math.Log, -1, 1) })
+	assert.Panics(t, func() { NewChebPolyFromFunc(func(x float64) float64 { return 1 / x }, -1, 1) })
+
+	defer SetChebMaxDegree(chebMaxDegree)
+	SetChebMaxDegree(64)
+
+	assert.Panics(t, func() { NewChebPolyFromFunc(math.Abs, -1, 1) })
+}
+
+func Test_NewChebPolyFromFunc(t *testing.T) {
+	testCases := []struct {
+		name   string
+		f      func(float64) float64
+		a, b   float64
+		maxDeg int
+	}{
+		{name: "exp", f: math.Exp, a: -1, b: 1, maxDeg: 20},
+		{name: "sin(10x)", f: func(x float64) float64 { return math.Sin(10 * x) }, a: 0, b: 5, maxDeg: 100},
+		{name: "runge", f: func(x float64) float64 { return 1 / (1 + 25*x*x) }, a: -1, b: 1, maxDeg: 250},
+		{name: "log", f: math.Log, a: 1, b: 100, maxDeg: 150},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewChebPolyFromFunc(tc.f, tc.a, tc.b)
+
+			assert.LessOrEqual(t, p.Degree(), tc.maxDeg)
+
+			for x := tc.a; x <= tc.b; x += (tc.b - tc.a) / 97 {
+				assert.InDelta(t, tc.f(x), p.At(x), 1e-11)
+			}
+		})
+	}
+
+	// Polynomials are recovered with their exact degree.
+	q := NewPoly([]float64{1, 0, -3, 2, 1})
+	p := NewChebPolyFromFunc(q.At, -2, 3)
+	assert.Equal(t, 4, p.Degree())
+	assert.InDeltaSlice(t, q.Coefficients(), p.ToPoly().Coefficients(), 1e-10)
+
+	assert.True(t, NewChebPolyFromFunc(func(float64) float64 { return 0 }, 0, 1).IsZero())
+}
+
+func Test_NewPolyFromFunc(t *testing.T) {
+
+	s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+
+	p := NewPolyFromFunc(math.Cos, 0, 5)
+	roots := s.FindRootsWithin(p, 0, 5)
+
+	assert.Len(t, roots, 2)
+	assert.InDelta(t, math.Pi/2, roots[0], 1e-6)
+	assert.InDelta(t, 3*math.Pi/2, roots[1], 1e-6)
+}
+
+func Test_SetChebPanic(t *testing.T) {
+
+	assert.Panics(t, func() { SetChebTolerance(-1) })
+	assert.Panics(t, func() { SetChebMaxDegree(15) })
+}
@@ -0,0 +1,336 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+)
+
+// A BernsteinPoly represents a univariate polynomial on an interval [a, b] in the Bernstein basis.
+//
+// The basis functions of degree n are B_(i,n)(t) = C(n, i)t^i(1 - t)^(n - i) for i = 0, ..., n,
+// composed with the affine map t = (x - a) / (b - a) taking [a, b] onto [0, 1]. The coefficients
+// (control values) mimic the shape of the polynomial on [a, b], which makes the basis well suited
+// to geometric work and root isolation.
+//
+// Unlike the other polynomial types, the degree n of a BernsteinPoly is that of its basis, which
+// may exceed the degree of the polynomial itself (see Elevate()).
+//
+// Note: in the documentation for each method of BernsteinPoly, we refer to the receiver instance
+// as "p".
+type BernsteinPoly struct {
+	coef []float64
+	deg  int
+	a    float64
+	b    float64
+}
+
+// NewBernsteinPoly returns a polynomial p on [a, b] with the given Bernstein coefficients.
+//
+// Let c = coefficients, let n = len(c) - 1 and let t = (x - a) / (b - a). Then, p is defined by
+//
+//   - p(x) = c[0]B_(0,n)(t) + c[1]B_(1,n)(t) + ... + c[n]B_(n,n)(t).
+//
+// Note that, unlike NewPoly(), the coefficients are ordered by basis index, so c[0] = p(a) and
+// c[n] = p(b).
+//
+// # Examples:
+//   - NewBernsteinPoly([]float64{0, 1}, 0, 1) represents p(x) = x.
+//   - NewBernsteinPoly([]float64{1, 0, 1}, 0, 1) represents p(x) = (1 - x)^2 + x^2.
+//
+// Panics if coefficients slice is empty or if a >= b.
+func NewBernsteinPoly(coefficients []float64, a, b float64) BernsteinPoly {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewBernsteinPoly: empty coefficients slice.")
+	}
+
+	if !(a < b) {
+		log.Panicf("NewBernsteinPoly: invalid interval [%f, %f].", a, b)
+	}
+
+	return newBernsteinPoly(append([]float64{}, coefficients...), a, b)
+}
+
+// newBernsteinPoly is just NewBernsteinPoly without the copy and checks.
+func newBernsteinPoly(coefficients []float64, a, b float64) BernsteinPoly {
+
+	return BernsteinPoly{
+		coef: coefficients,
+		deg:  len(coefficients) - 1,
+		a:    a,
+		b:    b,
+	}
+}
+
+// NewBernsteinPolyFromPoly returns p in the Bernstein basis of degree deg(p) on [a, b].
+//
+// The conversion is carried out in floating point. On an interval much wider than the spread of
+// the roots of p, the coefficients are far larger than the values of p near its roots, and their
+// rounding errors may hide sign changes. ALG_ISOLATE_BERNSTEIN avoids this with exact arithmetic.
+//
+// Panics if a >= b.
+func NewBernsteinPolyFromPoly(p Poly, a, b float64) BernsteinPoly {
+
+	if !(a < b) {
+		log.Panicf("NewBernsteinPolyFromPoly: invalid interval [%f, %f].", a, b)
+	}
+
+	// q(t) = p(a + (b - a)t).
+	q := p.Compose(NewPolyLinear(b-a, a))
+	n := p.deg
+
+	// t^k = sum over i >= k of (C(i, k) / C(n, k))B_(i,n)(t).
+	coef := make([]float64, n+1)
+	for i := 0; i <= n; i++ {
+		for k := 0; k <= i && k <= q.deg; k++ {
+			coef[i] += choose(i, k) / choose(n, k) * q.coef[k]
+		}
+	}
+
+	return newBernsteinPoly(coef, a, b)
+}
+
+// ToPoly returns p in the monomial basis.
+func (p BernsteinPoly) ToPoly() Poly {
+
+	n := p.deg
+
+	// B_(i,n)(t) expanded with the binomial theorem.
+	coef := make([]float64, n+1)
+	for k := 0; k <= n; k++ {
+		sum, sgn := 0.0, 1.0
+		if k%2 == 1 {
+			sgn = -1
+		}
+
+		for i := 0; i <= k; i++ {
+			sum += sgn * choose(k, i) * p.coef[i]
+			sgn = -sgn
+		}
+
+		coef[k] = choose(n, k) * sum
+	}
+
+	// p(x) = q((x - a) / (b - a)).
+	scale := 1 / (p.b - p.a)
+
+	return newPolyNoReverse(coef).Compose(NewPolyLinear(scale, -p.a*scale))
+}
+
+// Coefficients returns the Bernstein coefficients c of p ordered by basis index.
+func (p BernsteinPoly) Coefficients() []float64 {
+
+	return append([]float64{}, p.coef...)
+}
+
+// Degree returns the degree of the Bernstein basis of p.
+func (p BernsteinPoly) Degree() int {
+
+	return p.deg
+}
+
+// Interval returns the interval [a, b] on which p is defined.
+func (p BernsteinPoly) Interval() (float64, float64) {
+
+	return p.a, p.b
+}
+
+// deCasteljau returns the Bernstein coefficients of the restrictions of c to [0, t] and [t, 1].
+func deCasteljau(c []float64, t float64) ([]float64, []float64) {
+
+	n := len(c) - 1
+
+	work := append([]float64{}, c...)
+	left := make([]float64, n+1)
+	right := make([]float64, n+1)
+
+	left[0], right[n] = work[0], work[n]
+
+	for r := 1; r <= n; r++ {
+		for i := 0; i <= n-r; i++ {
+			work[i] = (1-t)*work[i] + t*work[i+1]
+		}
+
+		left[r] = work[0]
+		right[n-r] = work[n-r]
+	}
+
+	return left, right
+}
+
+// At returns the value of p evaluated at x.
+//
+// De Casteljau's algorithm is used, which is numerically stable for x in [a, b].
+func (p BernsteinPoly) At(x float64) float64 {
+
+	t := (x - p.a) / (p.b - p.a)
+
+	work := append([]float64{}, p.coef...)
+	for r := 1; r <= p.deg; r++ {
+		for i := 0; i <= p.deg-r; i++ {
+			work[i] = (1-t)*work[i] + t*work[i+1]
+		}
+	}
+
+	return work[0]
+}
+
+// Subdivide returns p restricted to [a, x] and to [x, b], each in the Bernstein basis of the same
+// degree on its own interval.
+//
+// Panics unless a < x < b.
+func (p BernsteinPoly) Subdivide(x float64) (BernsteinPoly, BernsteinPoly) {
+
+	if !(p.a < x && x < p.b) {
+		log.Panicf("Subdivide: %f not inside (%f, %f).", x, p.a, p.b)
+	}
+
+	left, right := deCasteljau(p.coef, (x-p.a)/(p.b-p.a))
+
+	return newBernsteinPoly(left, p.a, x), newBernsteinPoly(right, x, p.b)
+}
+
+// Elevate returns p in the Bernstein basis of degree n + 1, where n is the degree of p's basis.
+func (p BernsteinPoly) Elevate() BernsteinPoly {
+
+	n := p.deg
+	coef := make([]float64, n+2)
+
+	coef[0], coef[n+1] = p.coef[0], p.coef[n]
+	for i := 1; i <= n; i++ {
+		r := float64(i) / float64(n+1)
+		coef[i] = r*p.coef[i-1] + (1-r)*p.coef[i]
+	}
+
+	return newBernsteinPoly(coef, p.a, p.b)
+}
+
+// Derivative returns the derivative of p, in the Bernstein basis of degree n - 1 (or 0 if n = 0).
+func (p BernsteinPoly) Derivative() BernsteinPoly {
+
+	if p.deg == 0 {
+		return newBernsteinPoly([]float64{0}, p.a, p.b)
+	}
+
+	scale := float64(p.deg) / (p.b - p.a)
+
+	coef := make([]float64, p.deg)
+	for i := range coef {
+		coef[i] = scale * (p.coef[i+1] - p.coef[i])
+	}
+
+	return newBernsteinPoly(coef, p.a, p.b)
+}
+
+// IsolateRoots returns a sequence of non-overlapping half-open intervals (L, R], ordered from left
+// to right, each containing exactly one distinct root of p on (a, b].
+//
+// By the variation-diminishing property, the number of sign changes in the Bernstein coefficients
+// bounds the number of roots on (a, b), and a single sign change means exactly one root. p is
+// bisected with de Casteljau's algorithm until every piece has at most one sign change.
+//
+// Clusters of roots narrower than about 1e-12(b - a), such as multiple roots, are reported as a
+// single interval. Roots of even multiplicity do not change the sign of p and may be lost to
+// rounding errors, so p should be square-free (see Poly.SquareFreeFactorization()).
+//
+// Panics if p is identically zero.
+func (p BernsteinPoly) IsolateRoots() []HalfOpenInterval {
+
+	zero := true
+	for _, c := range p.coef {
+		if c != 0 {
+			zero = false
+			break
+		}
+	}
+
+	if zero {
+		log.Panic("IsolateRoots: zero polynomial.")
+	}
+
+	return isolate_bernstein(p.coef, p.a, p.b, 1e-12*(p.b-p.a))
+}
+
+// isolate_bernstein returns the isolating intervals of the roots on (l, r] of the polynomial with
+// Bernstein coefficients c on [l, r].
+func isolate_bernstein(c []float64, l, r, width float64) []HalfOpenInterval {
+
+	v := signVariations(c)
+
+	// A zero final coefficient is a root at r, which the sign variations do not see.
+	atR := c[len(c)-1] == 0
+
+	switch {
+	case v == 0 && !atR:
+		return []HalfOpenInterval{}
+
+	case v == 0 && atR, v == 1 && !atR, r-l <= width:
+		return []HalfOpenInterval{{l, r}}
+	}
+
+	m := 0.5 * (l + r)
+	left, right := deCasteljau(c, 0.5)
+
+	return append(isolate_bernstein(left, l, m, width), isolate_bernstein(right, m, r, width)...)
+}
+
+// bernstein_isolate returns the isolating intervals of the roots on (l, r] of the polynomial with
+// integer coefficients P, like BernsteinPoly.IsolateRoots(), clusters narrower than width aside.
+//
+// On a wide interval, the Bernstein coefficients are far larger than the values of P near its
+// roots, so in floating point their rounding errors would swamp the sign variations. Instead, P is
+// mapped onto each piece exactly (see mapInt()), and the Bernstein coefficients, scaled by C(n, i)
+// to make them integers, are computed with
+//
+//   - C(n, i)c_i = C(n - i, 0)q_i + C(n - i + 1, 1)q_(i - 1) + ... + C(n, i)q_0,
+//
+// where q holds the coefficients of the mapped polynomial.
+func bernstein_isolate(P []*big.Int, l, r, width float64) []HalfOpenInterval {
+
+	q := mapInt(P, l, r)
+	n := len(q) - 1
+
+	c := make([]*big.Int, n+1)
+	for i := range c {
+		c[i] = new(big.Int)
+		for k := 0; k <= i; k++ {
+			term := new(big.Int).Binomial(int64(n-k), int64(i-k))
+			c[i].Add(c[i], term.Mul(term, q[k]))
+		}
+	}
+
+	v := signVariationsInt(c)
+
+	// A zero final coefficient is a root at r, which the sign variations do not see.
+	atR := c[n].Sign() == 0
+
+	switch {
+	case v == 0 && !atR:
+		return []HalfOpenInterval{}
+
+	case v == 0 && atR, v == 1 && !atR, r-l <= width:
+		return []HalfOpenInterval{{l, r}}
+	}
+
+	m := 0.5 * (l + r)
+
+	return append(bernstein_isolate(P, l, m, width), bernstein_isolate(P, m, r, width)...)
+}
+
+// String returns a string representation of p in increasing basis index sum form.
+func (p BernsteinPoly) String() string {
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[ %fB_{0,%d}", p.coef[0], p.deg))
+
+	for i := 1; i <= p.deg; i++ {
+		sb.WriteString(fmt.Sprintf(" + %fB_{%d,%d}", p.coef[i], i, p.deg))
+	}
+
+	sb.WriteString(fmt.Sprintf(" ] on [%f, %f]", p.a, p.b))
+
+	return sb.String()
+}
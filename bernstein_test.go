@@ -0,0 +1,199 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in bernstein.go.
+*/
+
+func Test_NewBernsteinPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewBernsteinPoly([]float64{}, 0, 1) })
+	assert.Panics(t, func() { NewBernsteinPoly([]float64{1}, 1, 1) })
+	assert.Panics(t, func() { NewBernsteinPolyFromPoly(NewPolyConst(1), 1, 0) })
+	assert.Panics(t, func() { NewBernsteinPoly([]float64{1, 2}, 0, 1).Subdivide(1) })
+	assert.Panics(t, func() { NewBernsteinPoly([]float64{0, 0}, 0, 1).IsolateRoots() })
+}
+
+func Test_NewBernsteinPoly(t *testing.T) {
+
+	c := []float64{1, 0, 1}
+	p := NewBernsteinPoly(c, 0, 1)
+	c[0] = 5
+
+	assert.Equal(t, []float64{1, 0, 1}, p.Coefficients())
+	assert.Equal(t, 2, p.Degree())
+
+	a, b := p.Interval()
+	assert.Equal(t, 0.0, a)
+	assert.Equal(t, 1.0, b)
+
+	// (1 - x)^2 + x^2 = 2x^2 - 2x + 1.
+	assert.InDeltaSlice(t, []float64{2, -2, 1}, p.ToPoly().Coefficients(), 1e-15)
+	assert.InDelta(t, 0.5, p.At(0.5), 1e-15)
+}
+
+func Test_BernsteinPolyConversion(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    Poly
+		a, b float64
+	}{
+		{name: "constant", p: NewPolyConst(-2), a: 0, b: 1},
+		{name: "line", p: NewPolyLinear(3, 1), a: -1, b: 2},
+		{name: "cubic", p: NewPoly([]float64{1, -2, 0, 4}), a: 0, b: 1},
+		{name: "Chebyshev", p: NewPolyChebyshev1(8), a: -1, b: 1},
+		{name: "shifted", p: NewPolyFactored(2, []float64{3, 4, 4.5}), a: 2.5, b: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bp := NewBernsteinPolyFromPoly(tc.p, tc.a, tc.b)
+
+			assert.Equal(t, tc.p.Degree(), bp.Degree())
+			assert.InDelta(t, tc.p.At(tc.a), bp.Coefficients()[0], 1e-12)
+			assert.InDelta(t, tc.p.At(tc.b), bp.Coefficients()[bp.Degree()], 1e-12)
+
+			for x := tc.a; x <= tc.b; x += (tc.b - tc.a) / 9 {
+				assert.InDelta(t, tc.p.At(x), bp.At(x), 1e-11)
+			}
+
+			assert.InDeltaSlice(t, tc.p.Coefficients(), bp.ToPoly().Coefficients(), 1e-9)
+		})
+	}
+}
+
+func Test_BernsteinPolySubdivideElevate(t *testing.T) {
+
+	p := NewBernsteinPolyFromPoly(NewPoly([]float64{1, -2, 0, 4}), -1, 2)
+
+	left, right := p.Subdivide(0.5)
+	la, lb := left.Interval()
+	ra, rb := right.Interval()
+
+	assert.Equal(t, []float64{-1, 0.5, 0.5, 2}, []float64{la, lb, ra, rb})
+	assert.Equal(t, left.Coefficients()[3], right.Coefficients()[0])
+
+	e := p.Elevate()
+	assert.Equal(t, 4, e.Degree())
+
+	for x := -1.0; x <= 0.5; x += 0.25 {
+		assert.InDelta(t, p.At(x), left.At(x), 1e-12)
+		assert.InDelta(t, p.At(x), e.At(x), 1e-12)
+	}
+	for x := 0.5; x <= 2; x += 0.25 {
+		assert.InDelta(t, p.At(x), right.At(x), 1e-12)
+	}
+
+	// Elevation converges to the polynomial itself.
+	c := NewBernsteinPoly([]float64{0, 1, 0}, 0, 1)
+	for i := 0; i < 200; i++ {
+		c = c.Elevate()
+	}
+	for i, v := range c.Coefficients() {
+		x := float64(i) / float64(c.Degree())
+		assert.InDelta(t, 2*x*(1-x), v, 1e-2)
+	}
+}
+
+func Test_BernsteinPolyDerivative(t *testing.T) {
+
+	q := NewPoly([]float64{1, -2, 0, 4})
+	p := NewBernsteinPolyFromPoly(q, -1, 2)
+
+	d := p.Derivative()
+	assert.Equal(t, 2, d.Degree())
+
+	for x := -1.0; x <= 2; x += 0.25 {
+		assert.InDelta(t, q.Derivative().At(x), d.At(x), 1e-12)
+	}
+
+	assert.Equal(t, []float64{0}, NewBernsteinPoly([]float64{3}, 0, 1).Derivative().Coefficients())
+}
+
+func Test_BernsteinPolyIsolateRoots(t *testing.T) {
+	testCases := []struct {
+		name  string
+		p     Poly
+		a, b  float64
+		roots []float64
+		tol   float64
+	}{
+		{name: "no roots", p: NewPolyQuadratic(1, 0, 1), a: -2, b: 2, roots: []float64{}},
+		{name: "one root", p: NewPolyLinear(1, -0.3), a: 0, b: 1, roots: []float64{0.3}},
+		{name: "Wilkinson-like", p: NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6}), a: 0, b: 7,
+			roots: []float64{1, 2, 3, 4, 5, 6}},
+		{name: "root at left end excluded", p: NewPolyFactored(1, []float64{0, 0.5}), a: 0, b: 1,
+			roots: []float64{0.5}},
+		{name: "root at right end included", p: NewPolyFactored(1, []float64{0.25, 1}), a: 0, b: 1,
+			roots: []float64{0.25, 1}},
+		{name: "root at split point", p: NewPolyFactored(1, []float64{0.5, 0.75}), a: 0, b: 1,
+			roots: []float64{0.5, 0.75}},
+		{name: "triple root", p: NewPolyFactored(1, []float64{0.3, 0.3, 0.3, 0.8}), a: 0, b: 1,
+			roots: []float64{0.3, 0.8}, tol: 1e-4},
+		{name: "Chebyshev", p: NewPolyChebyshev1(9), a: -1, b: 1,
+			roots: []float64{
+				math.Cos(17 * math.Pi / 18), math.Cos(15 * math.Pi / 18), math.Cos(13 * math.Pi / 18),
+				math.Cos(11 * math.Pi / 18), 0, math.Cos(7 * math.Pi / 18), math.Cos(5 * math.Pi / 18),
+				math.Cos(3 * math.Pi / 18), math.Cos(math.Pi / 18)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewBernsteinPolyFromPoly(tc.p, tc.a, tc.b).IsolateRoots()
+
+			// A multiple root is only determined to about the cube root of machine precision.
+			tol := math.Max(tc.tol, 1e-12)
+
+			assert.Len(t, got, len(tc.roots))
+
+			for i, h := range got {
+				assert.True(t, h.L < h.R)
+				if i > 0 {
+					assert.LessOrEqual(t, got[i-1].R, h.L)
+				}
+				if i < len(tc.roots) {
+					assert.True(t, h.L < tc.roots[i]+tol && tc.roots[i] <= h.R+tol, "%v %v", h, tc.roots[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_SolverIsolateBernstein(t *testing.T) {
+
+	s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BERNSTEIN, ALG_SEARCH_BISECT)
+	p := NewPolyFactored(1, []float64{-2, -0.5, 1, 1.5, 3})
+
+	roots := s.FindRootsWithin(p, -5, 5)
+
+	assert.InDeltaSlice(t, []float64{-2, -0.5, 1, 1.5, 3}, roots, 1e-5)
+	assert.Equal(t, "ALG_ISOLATE_BERNSTEIN", ALG_ISOLATE_BERNSTEIN.String())
+	assert.Empty(t, s.IsolateRootsWithin(p, 3.5, 5))
+}
+
+func Test_SolverIsolateBernsteinWide(t *testing.T) {
+
+	// On a wide interval, the Bernstein coefficients dwarf the values of p near its roots.
+	p := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8})
+	want := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BERNSTEIN, ALG_SEARCH_BISECT)
+
+	got := s.IsolateRootsWithin(p, -1000, 1000)
+	assert.Len(t, got, 8)
+	for i, h := range got {
+		assert.True(t, h.L < want[i] && want[i] <= h.R, "%v %v", h, want[i])
+	}
+
+	assert.InDeltaSlice(t, want, s.FindRoots(p), 1e-5)
+
+	// A double root is kept, and reported once.
+	q := NewPolyFactored(1, []float64{-1, 2, 2})
+	assert.InDeltaSlice(t, []float64{-1, 2}, s.FindRootsWithin(q, -100, 100), 1e-5)
+}
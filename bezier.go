@@ -0,0 +1,436 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+)
+
+// A BezierCurve represents a plane Bezier curve B(t) = (x(t), y(t)) of arbitrary degree, for
+// parameters t in [0, 1].
+//
+// Given the control points P_0, ..., P_n, the components x and y are the polynomials with Bernstein
+// coefficients P_0.X, ..., P_n.X and P_0.Y, ..., P_n.Y on [0, 1] (see BernsteinPoly). The curve
+// starts at P_0, ends at P_n, and lies within the convex hull of its control points.
+//
+// Note: in the documentation for each method of BezierCurve, we refer to the receiver instance as
+// "c".
+type BezierCurve struct {
+	x BernsteinPoly
+	y BernsteinPoly
+}
+
+// NewBezierCurve returns the Bezier curve with the given control points.
+//
+// # Examples:
+//   - NewBezierCurve([]Point{{0, 0}, {1, 1}}) is the line segment from (0, 0) to (1, 1).
+//   - NewBezierCurve([]Point{{0, 0}, {1, 2}, {2, 0}}) is the parabolic arc y = x(2 - x) for x in
+//     [0, 2].
+//
+// Panics if the control points slice is empty.
+func NewBezierCurve(controlPoints []Point) BezierCurve {
+
+	if len(controlPoints) == 0 {
+		log.Panic("NewBezierCurve: empty control points slice.")
+	}
+
+	x := make([]float64, len(controlPoints))
+	y := make([]float64, len(controlPoints))
+
+	for i, pt := range controlPoints {
+		x[i], y[i] = pt.X, pt.Y
+	}
+
+	return newBezierCurve(x, y)
+}
+
+// newBezierCurve returns the Bezier curve with control points (x[i], y[i]), without copying x and
+// y.
+func newBezierCurve(x, y []float64) BezierCurve {
+
+	return BezierCurve{x: newBernsteinPoly(x, 0, 1), y: newBernsteinPoly(y, 0, 1)}
+}
+
+// ControlPoints returns the control points of c.
+func (c BezierCurve) ControlPoints() []Point {
+
+	pts := make([]Point, c.x.deg+1)
+	for i := range pts {
+		pts[i] = Point{X: c.x.coef[i], Y: c.y.coef[i]}
+	}
+
+	return pts
+}
+
+// Degree returns the degree of c, i.e. one less than its number of control points.
+func (c BezierCurve) Degree() int {
+
+	return c.x.deg
+}
+
+// X returns the x component of c as a polynomial in t.
+func (c BezierCurve) X() Poly {
+
+	return c.x.ToPoly()
+}
+
+// Y returns the y component of c as a polynomial in t.
+func (c BezierCurve) Y() Poly {
+
+	return c.y.ToPoly()
+}
+
+// At returns the point B(t) of c.
+//
+// De Casteljau's algorithm is used, which is numerically stable for t in [0, 1].
+func (c BezierCurve) At(t float64) Point {
+
+	return Point{X: c.x.At(t), Y: c.y.At(t)}
+}
+
+// Derivative returns the derivative (hodograph) B'(t) of c, a Bezier curve of degree n - 1 (or 0
+// if n = 0).
+func (c BezierCurve) Derivative() BezierCurve {
+
+	return BezierCurve{x: c.x.Derivative(), y: c.y.Derivative()}
+}
+
+// Tangent returns the unit tangent vector of c at t, i.e. B'(t) / |B'(t)|.
+//
+// If B'(t) = 0 (e.g. at a cusp, or for a curve of degree 0), the zero vector is returned.
+func (c BezierCurve) Tangent(t float64) Point {
+
+	d := c.Derivative().At(t)
+	norm := math.Hypot(d.X, d.Y)
+
+	if norm == 0 {
+		return Point{}
+	}
+
+	return Point{X: d.X / norm, Y: d.Y / norm}
+}
+
+// ArcLength returns the length of c.
+func (c BezierCurve) ArcLength() float64 {
+
+	return c.ArcLengthWithin(0, 1)
+}
+
+// ArcLengthWithin returns the length of c between the parameters t0 and t1, which is negative if
+// t1 < t0.
+//
+// The speed |B'(t)| is integrated with a composite 16-point Gauss-Legendre rule (see Integrate())
+// over 2n pieces, which is accurate to near machine precision unless c has (almost) a cusp.
+func (c BezierCurve) ArcLengthWithin(t0, t1 float64) float64 {
+
+	d := c.Derivative()
+	speed := func(t float64) float64 {
+		v := d.At(t)
+		return math.Hypot(v.X, v.Y)
+	}
+
+	pieces := 2 * c.x.deg
+	if pieces == 0 {
+		return 0
+	}
+
+	h := (t1 - t0) / float64(pieces)
+
+	length := 0.0
+	for i := 0; i < pieces; i++ {
+		length += Integrate(speed, t0+float64(i)*h, t0+float64(i+1)*h, 16)
+	}
+
+	return length
+}
+
+// Split returns c restricted to [0, t] and to [t, 1], each reparametrized over [0, 1] as a Bezier
+// curve of the same degree.
+//
+// Panics unless 0 < t < 1.
+func (c BezierCurve) Split(t float64) (BezierCurve, BezierCurve) {
+
+	if !(0 < t && t < 1) {
+		log.Panicf("Split: %f not inside (0, 1).", t)
+	}
+
+	xl, xr := deCasteljau(c.x.coef, t)
+	yl, yr := deCasteljau(c.y.coef, t)
+
+	return newBezierCurve(xl, yl), newBezierCurve(xr, yr)
+}
+
+// unit_roots returns the distinct roots of p on [0, 1] in increasing order, found with s and
+// polished with Newton's method. No roots are returned for the zero polynomial.
+func unit_roots(s Solver, p Poly) []float64 {
+
+	if p.deg == 0 {
+		return []float64{}
+	}
+
+	// FindRootsWithin() searches a half-open interval, so widen it slightly to include t = 0.
+	const eps = 1e-12
+
+	roots := []float64{}
+	for _, r := range s.FindRootsWithin(p, -eps, 1) {
+		if r < -eps || r > 1 {
+			continue
+		}
+
+		if x := p.SolveNewtonRaphson(r, 4); 0 <= x && x <= 1 && math.Abs(p.At(x)) < math.Abs(p.At(r)) {
+			r = x
+		}
+
+		roots = append(roots, math.Max(r, 0))
+	}
+
+	sort.Float64s(roots)
+
+	return roots
+}
+
+// BoundingBox returns the lower left and upper right corners of the smallest axis-aligned box
+// containing c.
+//
+// The extremes of each component lie at t = 0, t = 1 or at a root of its derivative on (0, 1),
+// which are found with s.
+func (c BezierCurve) BoundingBox(s Solver) (Point, Point) {
+
+	lo, hi := c.At(0), c.At(0)
+
+	update := func(pt Point) {
+		lo = Point{X: math.Min(lo.X, pt.X), Y: math.Min(lo.Y, pt.Y)}
+		hi = Point{X: math.Max(hi.X, pt.X), Y: math.Max(hi.Y, pt.Y)}
+	}
+
+	update(c.At(1))
+
+	for _, comp := range []BernsteinPoly{c.x, c.y} {
+		for _, t := range unit_roots(s, comp.ToPoly().Derivative()) {
+			update(c.At(t))
+		}
+	}
+
+	return lo, hi
+}
+
+// IntersectLine returns the parameters t in [0, 1], in increasing order, at which c meets the
+// (infinite) line through p0 and p1.
+//
+// The intersections are the roots of n . (B(t) - p0), where n is normal to the line, and are found
+// with s.
+//
+// Panics if p0 = p1 or if c lies on the line.
+func (c BezierCurve) IntersectLine(s Solver, p0, p1 Point) []float64 {
+
+	if p0 == p1 {
+		log.Panic("IntersectLine: line points are equal.")
+	}
+
+	nx, ny := p1.Y-p0.Y, p0.X-p1.X
+
+	f := c.X().MulScalar(nx).Add(c.Y().MulScalar(ny)).Sub(NewPolyConst(nx*p0.X + ny*p0.Y))
+
+	if f.IsZero() {
+		log.Panic("IntersectLine: curve lies on the line.")
+	}
+
+	return unit_roots(s, f)
+}
+
+// IntersectCurve returns the parameters (t[i], u[i]), ordered by t, at which c meets d, so that
+// c.At(t[i]) = d.At(u[i]).
+//
+// The curves are subdivided wherever the bounding boxes of their control points overlap, until
+// both pieces are flat enough to be treated as line segments. Each crossing of the segments is
+// then polished with Newton's method on B_c(t) - B_d(u) = 0.
+//
+// Tangential intersections may be missed or repeated, and overlapping curves (which meet at
+// infinitely many points) are not detected.
+func (c BezierCurve) IntersectCurve(d BezierCurve) ([]float64, []float64) {
+
+	lo1, hi1 := controlBox(c)
+	lo2, hi2 := controlBox(d)
+	scale := math.Max(
+		math.Max(hi1.X-lo1.X, hi1.Y-lo1.Y),
+		math.Max(hi2.X-lo2.X, hi2.Y-lo2.Y),
+	)
+
+	pairs := [][2]float64{}
+	intersect_bezier(bezierPiece{c, 0, 1}, bezierPiece{d, 0, 1}, 1e-9*scale, 0, &pairs)
+
+	// Polish, then drop the duplicates found by neighbouring pieces.
+	dc, dd := c.Derivative(), d.Derivative()
+	for i := range pairs {
+		pairs[i][0], pairs[i][1] = newton_bezier(c, d, dc, dd, pairs[i][0], pairs[i][1])
+	}
+
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+
+	ts, us := []float64{}, []float64{}
+	for i, pr := range pairs {
+		if i > 0 && math.Abs(pr[0]-ts[len(ts)-1]) < 1e-8 && math.Abs(pr[1]-us[len(us)-1]) < 1e-8 {
+			continue
+		}
+		ts = append(ts, pr[0])
+		us = append(us, pr[1])
+	}
+
+	return ts, us
+}
+
+// A bezierPiece is the restriction of a Bezier curve to the parameters [t0, t1], reparametrized
+// over [0, 1].
+type bezierPiece struct {
+	c      BezierCurve
+	t0, t1 float64
+}
+
+// controlBox returns the lower left and upper right corners of the bounding box of the control
+// points of c, which contains c.
+func controlBox(c BezierCurve) (Point, Point) {
+
+	return Point{X: min(c.x.coef), Y: min(c.y.coef)}, Point{X: max(c.x.coef), Y: max(c.y.coef)}
+}
+
+// flatness returns the largest distance of a control point of c from the chord through its end
+// points.
+func flatness(c BezierCurve) float64 {
+
+	n := c.x.deg
+	x0, y0 := c.x.coef[0], c.y.coef[0]
+	dx, dy := c.x.coef[n]-x0, c.y.coef[n]-y0
+	chord := math.Hypot(dx, dy)
+
+	flat := 0.0
+	for i := 1; i < n; i++ {
+		px, py := c.x.coef[i]-x0, c.y.coef[i]-y0
+		if chord == 0 {
+			flat = math.Max(flat, math.Hypot(px, py))
+		} else {
+			flat = math.Max(flat, math.Abs(px*dy-py*dx)/chord)
+		}
+	}
+
+	return flat
+}
+
+// intersect_bezier appends to out the parameter pairs at which the chords of the pieces a and b
+// cross, once they are within tol of flat.
+func intersect_bezier(a, b bezierPiece, tol float64, depth int, out *[][2]float64) {
+
+	lo1, hi1 := controlBox(a.c)
+	lo2, hi2 := controlBox(b.c)
+
+	if lo1.X > hi2.X+tol || lo2.X > hi1.X+tol || lo1.Y > hi2.Y+tol || lo2.Y > hi1.Y+tol {
+		return
+	}
+
+	fa, fb := flatness(a.c), flatness(b.c)
+
+	if (fa <= tol && fb <= tol) || depth == 64 {
+		if s, u, ok := chordCrossing(a.c, b.c); ok {
+			*out = append(*out, [2]float64{a.t0 + s*(a.t1-a.t0), b.t0 + u*(b.t1-b.t0)})
+		}
+		return
+	}
+
+	// Split the less flat piece.
+	if fa >= fb {
+		m := 0.5 * (a.t0 + a.t1)
+		l, r := a.c.Split(0.5)
+		intersect_bezier(bezierPiece{l, a.t0, m}, b, tol, depth+1, out)
+		intersect_bezier(bezierPiece{r, m, a.t1}, b, tol, depth+1, out)
+	} else {
+		m := 0.5 * (b.t0 + b.t1)
+		l, r := b.c.Split(0.5)
+		intersect_bezier(a, bezierPiece{l, b.t0, m}, tol, depth+1, out)
+		intersect_bezier(a, bezierPiece{r, m, b.t1}, tol, depth+1, out)
+	}
+}
+
+// chordCrossing returns the parameters s and u at which the chords of a and b cross, if they do
+// (allowing a small slack at the end points).
+func chordCrossing(a, b BezierCurve) (float64, float64, bool) {
+
+	const slack = 1e-6
+
+	na, nb := a.x.deg, b.x.deg
+	ax, ay := a.x.coef[0], a.y.coef[0]
+	bx, by := b.x.coef[0], b.y.coef[0]
+	dax, day := a.x.coef[na]-ax, a.y.coef[na]-ay
+	dbx, dby := b.x.coef[nb]-bx, b.y.coef[nb]-by
+
+	// Solve ax + s dax = bx + u dbx, ay + s day = by + u dby.
+	det := dbx*day - dax*dby
+	if det == 0 {
+		return 0, 0, false
+	}
+
+	s := (dbx*(by-ay) - dby*(bx-ax)) / det
+	u := (dax*(by-ay) - day*(bx-ax)) / det
+
+	if s < -slack || s > 1+slack || u < -slack || u > 1+slack {
+		return 0, 0, false
+	}
+
+	return math.Min(math.Max(s, 0), 1), math.Min(math.Max(u, 0), 1), true
+}
+
+// newton_bezier refines the approximate intersection (t, u) of c and d, whose derivatives are dc
+// and dd, with Newton's method.
+func newton_bezier(c, d, dc, dd BezierCurve, t, u float64) (float64, float64) {
+
+	residual := func(t, u float64) (float64, float64) {
+		p, q := c.At(t), d.At(u)
+		return p.X - q.X, p.Y - q.Y
+	}
+
+	fx, fy := residual(t, u)
+
+	for i := 0; i < 8; i++ {
+		jc, jd := dc.At(t), dd.At(u)
+
+		// Solve [jc, -jd] (dt, du) = -(fx, fy).
+		det := -jc.X*jd.Y + jd.X*jc.Y
+		if det == 0 {
+			break
+		}
+
+		dt := (fx*jd.Y - fy*jd.X) / det
+		du := (fx*jc.Y - fy*jc.X) / det
+
+		nt, nu := t+dt, u+du
+		if nt < 0 || nt > 1 || nu < 0 || nu > 1 {
+			break
+		}
+
+		nfx, nfy := residual(nt, nu)
+		if math.Hypot(nfx, nfy) >= math.Hypot(fx, fy) {
+			break
+		}
+
+		t, u, fx, fy = nt, nu, nfx, nfy
+	}
+
+	return t, u
+}
+
+// String returns a string representation of c as its list of control points.
+func (c BezierCurve) String() string {
+
+	var sb strings.Builder
+
+	sb.WriteString("Bezier[")
+	for i, pt := range c.ControlPoints() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("(%f, %f)", pt.X, pt.Y))
+	}
+	sb.WriteString("]")
+
+	return sb.String()
+}
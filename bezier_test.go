@@ -0,0 +1,232 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in bezier.go.
+*/
+
+func Test_NewBezierCurvePanic(t *testing.T) {
+
+	c := NewBezierCurve([]Point{{0, 0}, {1, 1}})
+	s := NewSolverDefault()
+
+	assert.Panics(t, func() { NewBezierCurve([]Point{}) })
+	assert.Panics(t, func() { c.Split(0) })
+	assert.Panics(t, func() { c.Split(1) })
+	assert.Panics(t, func() { c.IntersectLine(s, Point{1, 2}, Point{1, 2}) })
+	assert.Panics(t, func() { c.IntersectLine(s, Point{-1, -1}, Point{2, 2}) })
+}
+
+func Test_NewBezierCurve(t *testing.T) {
+
+	pts := []Point{{0, 0}, {1, 2}, {2, 0}}
+	c := NewBezierCurve(pts)
+	pts[0] = Point{5, 5}
+
+	assert.Equal(t, []Point{{0, 0}, {1, 2}, {2, 0}}, c.ControlPoints())
+	assert.Equal(t, 2, c.Degree())
+
+	// x(t) = 2t, y(t) = 4t - 4t^2.
+	assert.InDeltaSlice(t, []float64{2, 0}, c.X().Coefficients(), 1e-15)
+	assert.InDeltaSlice(t, []float64{-4, 4, 0}, c.Y().Coefficients(), 1e-15)
+
+	assert.Equal(t, "Bezier[(0.000000, 0.000000), (1.000000, 2.000000), (2.000000, 0.000000)]", c.String())
+}
+
+func Test_BezierCurveAt(t *testing.T) {
+
+	c := NewBezierCurve([]Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}})
+	x, y := c.X(), c.Y()
+
+	assert.Equal(t, Point{0, 0}, c.At(0))
+	assert.Equal(t, Point{4, 2}, c.At(1))
+
+	for tt := 0.0; tt <= 1; tt += 0.125 {
+		pt := c.At(tt)
+		assert.InDelta(t, x.At(tt), pt.X, 1e-14)
+		assert.InDelta(t, y.At(tt), pt.Y, 1e-14)
+	}
+}
+
+func Test_BezierCurveDerivativeTangent(t *testing.T) {
+
+	c := NewBezierCurve([]Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}})
+	d := c.Derivative()
+
+	assert.Equal(t, 2, d.Degree())
+	assert.Equal(t, []Point{{3, 9}, {6, -12}, {3, 9}}, d.ControlPoints())
+
+	for tt := 0.0; tt <= 1; tt += 0.125 {
+		v := d.At(tt)
+		assert.InDelta(t, c.X().Derivative().At(tt), v.X, 1e-13)
+		assert.InDelta(t, c.Y().Derivative().At(tt), v.Y, 1e-13)
+
+		u := c.Tangent(tt)
+		assert.InDelta(t, 1, math.Hypot(u.X, u.Y), 1e-15)
+		assert.InDelta(t, 0, u.X*v.Y-u.Y*v.X, 1e-13)
+	}
+
+	// A cusp.
+	cusp := NewBezierCurve([]Point{{0, 0}, {1, 1}, {0, 1}, {1, 0}})
+	assert.Equal(t, Point{}, cusp.Tangent(0.5))
+
+	assert.Equal(t, Point{}, NewBezierCurve([]Point{{1, 1}}).Tangent(0.5))
+}
+
+func Test_BezierCurveArcLength(t *testing.T) {
+
+	// Line segment.
+	assert.InDelta(t, 5, NewBezierCurve([]Point{{0, 0}, {0.6, 0.8}, {3, 4}}).ArcLength(), 1e-12)
+	assert.InDelta(t, 5, NewBezierCurve([]Point{{0, 0}, {3, 4}}).ArcLength(), 1e-14)
+	assert.Equal(t, 0.0, NewBezierCurve([]Point{{1, 1}}).ArcLength())
+
+	// Parabola y = x^2 on [0, 1]: length = sqrt(5) / 2 + asinh(2) / 4.
+	c := NewBezierCurve([]Point{{0, 0}, {0.5, 0}, {1, 1}})
+	assert.InDelta(t, math.Sqrt(5)/2+math.Asinh(2)/4, c.ArcLength(), 1e-13)
+
+	// Halves add up, and the length is additive over Split().
+	l, r := c.Split(0.3)
+	assert.InDelta(t, c.ArcLength(), c.ArcLengthWithin(0, 0.3)+c.ArcLengthWithin(0.3, 1), 1e-13)
+	assert.InDelta(t, c.ArcLengthWithin(0, 0.3), l.ArcLength(), 1e-13)
+	assert.InDelta(t, c.ArcLengthWithin(0.3, 1), r.ArcLength(), 1e-13)
+	assert.InDelta(t, -c.ArcLengthWithin(0, 0.3), c.ArcLengthWithin(0.3, 0), 1e-15)
+
+	// Cubic approximation of a quarter circle, whose radius stays within 2.8e-4 of 1.
+	k := 4 * (math.Sqrt2 - 1) / 3
+	q := NewBezierCurve([]Point{{1, 0}, {1, k}, {k, 1}, {0, 1}})
+	assert.InDelta(t, math.Pi/2, q.ArcLength(), 5e-4)
+}
+
+func Test_BezierCurveSplit(t *testing.T) {
+
+	c := NewBezierCurve([]Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}})
+	l, r := c.Split(0.25)
+
+	assert.Equal(t, 3, l.Degree())
+	assert.Equal(t, 3, r.Degree())
+	assert.Equal(t, l.ControlPoints()[3], r.ControlPoints()[0])
+
+	for tt := 0.0; tt <= 1; tt += 0.125 {
+		p, q := c.At(0.25*tt), l.At(tt)
+		assert.InDelta(t, p.X, q.X, 1e-14)
+		assert.InDelta(t, p.Y, q.Y, 1e-14)
+
+		p, q = c.At(0.25+0.75*tt), r.At(tt)
+		assert.InDelta(t, p.X, q.X, 1e-14)
+		assert.InDelta(t, p.Y, q.Y, 1e-14)
+	}
+}
+
+func Test_BezierCurveBoundingBox(t *testing.T) {
+	testCases := []struct {
+		name   string
+		pts    []Point
+		lo, hi Point
+	}{
+		{name: "point", pts: []Point{{1, 2}}, lo: Point{1, 2}, hi: Point{1, 2}},
+		{name: "segment", pts: []Point{{3, 0}, {1, 2}}, lo: Point{1, 0}, hi: Point{3, 2}},
+		{name: "parabola", pts: []Point{{0, 0}, {1, 2}, {2, 0}}, lo: Point{0, 0}, hi: Point{2, 1}},
+		// The remaining boxes are checked against dense sampling.
+		{name: "cubic", pts: []Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}}},
+		{name: "loop", pts: []Point{{0, 0}, {2, 1}, {-1, 1}, {1, 0}}},
+		{name: "quartic", pts: []Point{{0, 1}, {-2, 4}, {3, -3}, {5, 5}, {1, 0}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewBezierCurve(tc.pts)
+			lo, hi := c.BoundingBox(NewSolverDefault())
+
+			if tc.lo == tc.hi && tc.lo == (Point{}) {
+				// Compare with dense sampling.
+				tc.lo, tc.hi = c.At(0), c.At(0)
+				for i := 0; i <= 100000; i++ {
+					p := c.At(float64(i) / 100000)
+					tc.lo = Point{math.Min(tc.lo.X, p.X), math.Min(tc.lo.Y, p.Y)}
+					tc.hi = Point{math.Max(tc.hi.X, p.X), math.Max(tc.hi.Y, p.Y)}
+				}
+			}
+
+			assert.InDelta(t, tc.lo.X, lo.X, 1e-9)
+			assert.InDelta(t, tc.lo.Y, lo.Y, 1e-9)
+			assert.InDelta(t, tc.hi.X, hi.X, 1e-9)
+			assert.InDelta(t, tc.hi.Y, hi.Y, 1e-9)
+		})
+	}
+}
+
+func Test_BezierCurveIntersectLine(t *testing.T) {
+
+	s := NewSolverDefault()
+
+	// y = 4t - 4t^2 meets y = 0.75 at t = 1/4 and 3/4.
+	c := NewBezierCurve([]Point{{0, 0}, {1, 2}, {2, 0}})
+	assert.InDeltaSlice(t, []float64{0.25, 0.75}, c.IntersectLine(s, Point{-1, 0.75}, Point{1, 0.75}), 1e-12)
+
+	// End points are included.
+	assert.InDeltaSlice(t, []float64{0, 1}, c.IntersectLine(s, Point{0, 0}, Point{2, 0}), 1e-12)
+
+	// No intersection.
+	assert.Empty(t, c.IntersectLine(s, Point{0, 2}, Point{1, 2}))
+
+	// x(t) is increasing, so the cubic crosses x = 2 once.
+	q := NewBezierCurve([]Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}})
+	got := q.IntersectLine(s, Point{2, 0}, Point{2, 1})
+	assert.Len(t, got, 1)
+	assert.InDelta(t, 2, q.At(got[0]).X, 1e-12)
+
+	// The cubic crosses y = 1 three times.
+	got = q.IntersectLine(s, Point{0, 1}, Point{1, 1})
+	assert.Len(t, got, 3)
+	for _, tt := range got {
+		assert.InDelta(t, 1, q.At(tt).Y, 1e-12)
+	}
+}
+
+func Test_BezierCurveIntersectCurve(t *testing.T) {
+	testCases := []struct {
+		name string
+		c, d BezierCurve
+		n    int
+	}{
+		{name: "segments", c: NewBezierCurve([]Point{{0, 0}, {2, 2}}), d: NewBezierCurve([]Point{{0, 2}, {2, 0}}), n: 1},
+		{name: "disjoint", c: NewBezierCurve([]Point{{0, 0}, {1, 0}}), d: NewBezierCurve([]Point{{0, 1}, {1, 1}}), n: 0},
+		{name: "parabola and segment",
+			c: NewBezierCurve([]Point{{0, 0}, {1, 2}, {2, 0}}),
+			d: NewBezierCurve([]Point{{-1, 0.5}, {3, 0.5}}), n: 2},
+		{name: "two cubics",
+			c: NewBezierCurve([]Point{{0, 0}, {1, 3}, {3, -1}, {4, 2}}),
+			d: NewBezierCurve([]Point{{0, 2}, {1, -1}, {3, 3}, {4, 0}}), n: 3},
+		{name: "shared end point",
+			c: NewBezierCurve([]Point{{0, 0}, {1, 1}, {2, 0}}),
+			d: NewBezierCurve([]Point{{2, 0}, {3, 1}, {4, 0}}), n: 1},
+		{name: "self-similar",
+			c: NewBezierCurve([]Point{{0, 0}, {1, 2}, {2, 0}}),
+			d: NewBezierCurve([]Point{{0, 1}, {1, -1}, {2, 1}}), n: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, us := tc.c.IntersectCurve(tc.d)
+
+			assert.Len(t, ts, tc.n)
+			assert.Len(t, us, tc.n)
+
+			for i := range ts {
+				p, q := tc.c.At(ts[i]), tc.d.At(us[i])
+				assert.InDelta(t, p.X, q.X, 1e-12)
+				assert.InDelta(t, p.Y, q.Y, 1e-12)
+
+				if i > 0 {
+					assert.Less(t, ts[i-1], ts[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,477 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// A BigPoly represents a univariate real polynomial with arbitrary-precision coefficients.
+//
+// Every coefficient and every intermediate result of a BigPoly is rounded to the precision (in
+// mantissa bits) given at construction time.
+//
+// Note: in the documentation for each method of BigPoly, we refer to the receiver instance as "p".
+type BigPoly struct {
+	coef []*big.Float
+	len  int
+	deg  int
+	prec uint
+}
+
+// NewBigPoly returns an arbitrary-precision polynomial p with the given coefficients and precision
+// prec (in mantissa bits).
+//
+// Let c = coefficients and let n = len(c). Then, p is defined by
+//
+//   - p(x) = c[0]x^(n-1) + c[1]x^(n-2) + ... + c[n-2]x^1 + c[n-1]x^0.
+//
+// The coefficients are copied, so the caller may modify them afterwards.
+//
+// Panics if coefficients slice is empty or contains nil, or if prec is 0.
+func NewBigPoly(coefficients []*big.Float, prec uint) BigPoly {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewBigPoly: empty coefficients slice.")
+	}
+
+	if prec == 0 {
+		log.Panic("NewBigPoly: zero precision.")
+	}
+
+	n := len(coefficients)
+	coef := make([]*big.Float, n)
+
+	for i, c := range coefficients {
+		if c == nil {
+			log.Panic("NewBigPoly: nil coefficient.")
+		}
+		coef[n-i-1] = new(big.Float).SetPrec(prec).Set(c)
+	}
+
+	return newBigPolyNoReverse(coef, prec)
+}
+
+// newBigPolyNoReverse is just NewBigPoly but with no coefficient slice reversal or copying.
+func newBigPolyNoReverse(coefficients []*big.Float, prec uint) BigPoly {
+
+	for len(coefficients) > 1 && coefficients[len(coefficients)-1].Sign() == 0 {
+		coefficients = coefficients[:len(coefficients)-1]
+	}
+
+	coefLen := len(coefficients)
+
+	return BigPoly{
+		coef: coefficients,
+		len:  coefLen,
+		deg:  coefLen - 1,
+		prec: prec,
+	}
+}
+
+// NewBigPolyFromPoly returns p as an arbitrary-precision polynomial with precision prec.
+//
+// Panics if prec is 0.
+func NewBigPolyFromPoly(p Poly, prec uint) BigPoly {
+
+	if prec == 0 {
+		log.Panic("NewBigPolyFromPoly: zero precision.")
+	}
+
+	coef := make([]*big.Float, p.len)
+	for i, c := range p.coef {
+		coef[i] = new(big.Float).SetPrec(prec).SetFloat64(c)
+	}
+
+	return newBigPolyNoReverse(coef, prec)
+}
+
+// NewBigPolyFromRatPoly returns p as an arbitrary-precision polynomial with precision prec.
+//
+// This is the preferred way to build polynomials with large integer coefficients (such as
+// Wilkinson's), since they cannot be represented exactly by a Poly.
+//
+// Panics if prec is 0.
+func NewBigPolyFromRatPoly(p RatPoly, prec uint) BigPoly {
+
+	if prec == 0 {
+		log.Panic("NewBigPolyFromRatPoly: zero precision.")
+	}
+
+	coef := make([]*big.Float, p.len)
+	for i, c := range p.coef {
+		coef[i] = new(big.Float).SetPrec(prec).SetRat(c)
+	}
+
+	return newBigPolyNoReverse(coef, prec)
+}
+
+// ToPoly returns p as a Poly, with each coefficient rounded to the nearest float64.
+func (p BigPoly) ToPoly() Poly {
+
+	coef := make([]float64, p.len)
+	for i, c := range p.coef {
+		coef[i], _ = c.Float64()
+	}
+
+	return newPolyNoReverse(coef)
+}
+
+// newFloat returns a new zero-valued big.Float with the precision of p.
+func (p BigPoly) newFloat() *big.Float {
+
+	return new(big.Float).SetPrec(p.prec)
+}
+
+// Precision returns the precision of p in mantissa bits.
+func (p BigPoly) Precision() uint {
+
+	return p.prec
+}
+
+// Coefficients returns copies of the coefficients c of p ordered in decreasing degree.
+func (p BigPoly) Coefficients() []*big.Float {
+
+	ret := make([]*big.Float, p.len)
+	for i, c := range p.coef {
+		ret[p.len-i-1] = p.newFloat().Set(c)
+	}
+
+	return ret
+}
+
+// Degree returns the degree of p.
+func (p BigPoly) Degree() int {
+
+	return p.deg
+}
+
+// IsConstant returns true p is constant (i.e. deg(p) = 0), else false.
+func (p BigPoly) IsConstant() bool {
+
+	return p.deg == 0
+}
+
+// IsZero returns true if p(x) = 0, else false.
+func (p BigPoly) IsZero() bool {
+
+	return p.deg == 0 && p.coef[0].Sign() == 0
+}
+
+// At returns the value of p evaluated at x.
+func (p BigPoly) At(x *big.Float) *big.Float {
+
+	// Implement Horner's scheme.
+	out := p.newFloat().Set(p.coef[p.deg])
+	for i := p.deg - 1; i >= 0; i-- {
+		out.Mul(out, x)
+		out.Add(out, p.coef[i])
+	}
+
+	return out
+}
+
+// Add returns the polynomial sum p + q.
+//
+// The result has the larger of the two precisions.
+func (p BigPoly) Add(q BigPoly) BigPoly {
+
+	prec := p.prec
+	if q.prec > prec {
+		prec = q.prec
+	}
+
+	max := p.len
+	if q.len > max {
+		max = q.len
+	}
+
+	sumCoef := make([]*big.Float, max)
+
+	for i := 0; i < max; i++ {
+		sumCoef[i] = new(big.Float).SetPrec(prec)
+		if i < p.len {
+			sumCoef[i].Add(sumCoef[i], p.coef[i])
+		}
+		if i < q.len {
+			sumCoef[i].Add(sumCoef[i], q.coef[i])
+		}
+	}
+
+	return newBigPolyNoReverse(sumCoef, prec)
+}
+
+// Sub returns the polynomial difference p - q.
+//
+// The result has the larger of the two precisions.
+func (p BigPoly) Sub(q BigPoly) BigPoly {
+
+	return p.Add(q.MulScalar(big.NewFloat(-1)))
+}
+
+// MulScalar returns the scalar-polynomial product sp.
+func (p BigPoly) MulScalar(s *big.Float) BigPoly {
+
+	prodCoef := make([]*big.Float, p.len)
+	for i, c := range p.coef {
+		prodCoef[i] = p.newFloat().Mul(s, c)
+	}
+
+	return newBigPolyNoReverse(prodCoef, p.prec)
+}
+
+// Mul returns the polynomial product pq.
+//
+// The result has the larger of the two precisions.
+func (p BigPoly) Mul(q BigPoly) BigPoly {
+
+	prec := p.prec
+	if q.prec > prec {
+		prec = q.prec
+	}
+
+	prodCoef := make([]*big.Float, p.deg+q.deg+1)
+	for i := range prodCoef {
+		prodCoef[i] = new(big.Float).SetPrec(prec)
+	}
+
+	tmp := new(big.Float).SetPrec(prec)
+
+	for i := 0; i < p.len; i++ {
+		for j := 0; j < q.len; j++ {
+			prodCoef[i+j].Add(prodCoef[i+j], tmp.Mul(p.coef[i], q.coef[j]))
+		}
+	}
+
+	return newBigPolyNoReverse(prodCoef, prec)
+}
+
+// Div returns m (polynomial quotient) and n (polynomial remainder) such that p/q = m + n/q.
+//
+// The remainder always has degree less than that of q, even when rounding leaves a tiny leading
+// term behind. The results have the precision of p.
+//
+// Panics if q = 0.
+func (p BigPoly) Div(q BigPoly) (BigPoly, BigPoly) {
+
+	if q.IsZero() {
+		log.Panic("Div: division by zero polynomial.")
+	}
+
+	if p.deg < q.deg {
+		return newBigPolyNoReverse([]*big.Float{p.newFloat()}, p.prec), p
+	}
+
+	rem := make([]*big.Float, p.len)
+	for i, c := range p.coef {
+		rem[i] = p.newFloat().Set(c)
+	}
+
+	quoCoef := make([]*big.Float, p.deg-q.deg+1)
+	lead := q.coef[q.deg]
+	tmp := p.newFloat()
+
+	for i := p.deg - q.deg; i >= 0; i-- {
+		c := p.newFloat().Quo(rem[i+q.deg], lead)
+		quoCoef[i] = c
+
+		for j := 0; j < q.deg; j++ {
+			rem[i+j].Sub(rem[i+j], tmp.Mul(c, q.coef[j]))
+		}
+	}
+
+	remLen := q.deg
+	if remLen == 0 {
+		rem[0].SetInt64(0)
+		remLen = 1
+	}
+
+	return newBigPolyNoReverse(quoCoef, p.prec), newBigPolyNoReverse(rem[:remLen], p.prec)
+}
+
+// Derivative returns the derivative of p.
+func (p BigPoly) Derivative() BigPoly {
+
+	if p.deg == 0 {
+		return newBigPolyNoReverse([]*big.Float{p.newFloat()}, p.prec)
+	}
+
+	derivCoef := make([]*big.Float, p.deg)
+	for i := 0; i < p.deg; i++ {
+		derivCoef[i] = p.newFloat().Mul(p.coef[i+1], big.NewFloat(float64(i+1)))
+	}
+
+	return newBigPolyNoReverse(derivCoef, p.prec)
+}
+
+// CauchyBound returns Cauchy's root bound of p.
+//
+// Panics for constant p.
+func (p BigPoly) CauchyBound() *big.Float {
+
+	if p.deg == 0 {
+		log.Panic("CauchyBound: constant polynomial.")
+	}
+
+	maxi := p.newFloat()
+	tmp := p.newFloat()
+
+	for i := 0; i < p.deg; i++ {
+		tmp.Quo(p.coef[i], p.coef[p.deg])
+		tmp.Abs(tmp)
+
+		if tmp.Cmp(maxi) > 0 {
+			maxi.Set(tmp)
+		}
+	}
+
+	return maxi.Add(maxi, big.NewFloat(1))
+}
+
+// CountSturm returns the number of distinct real roots of p on the interval (a, b].
+func (p BigPoly) CountSturm(a, b *big.Float) int {
+
+	return new_bigSturmChain(p).count(a, b)
+}
+
+// Text returns a string representation of p in decreasing-degree sum form with its coefficients
+// printed to n significant digits.
+func (p BigPoly) Text(n int) string {
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[ %sx^{%d}", p.coef[p.deg].Text('g', n), p.deg))
+
+	for i := 1; i < p.len; i++ {
+		c := p.coef[p.deg-i]
+
+		if c.Sign() == -1 {
+			sb.WriteString(" - ")
+			sb.WriteString(new(big.Float).Neg(c).Text('g', n))
+		} else {
+			sb.WriteString(" + ")
+			sb.WriteString(c.Text('g', n))
+		}
+
+		sb.WriteString(fmt.Sprintf("x^{%d}", p.deg-i))
+	}
+
+	sb.WriteString(" ]")
+
+	return sb.String()
+}
+
+// String returns a string representation of p in decreasing-degree sum form with its coefficients
+// printed to the number of decimal digits supported by its precision.
+func (p BigPoly) String() string {
+
+	return p.Text(int(math.Ceil(float64(p.prec) * math.Log10(2))))
+}
+
+// bisect_converged returns true if the interval [left, right] is narrow relative to the precision
+// prec.
+func bisect_converged(left, right *big.Float, prec uint) bool {
+
+	width := new(big.Float).Sub(right, left)
+
+	scale := new(big.Float).Abs(left)
+	if absr := new(big.Float).Abs(right); absr.Cmp(scale) > 0 {
+		scale = absr
+	}
+
+	if scale.Cmp(big.NewFloat(1)) < 0 {
+		scale.SetFloat64(1)
+	}
+
+	// Leave a few guard bits for the rounding in the Sturm chain.
+	tol := new(big.Float).SetMantExp(scale, -int(prec)+4)
+
+	return width.Cmp(tol) <= 0
+}
+
+// isolate_big_roots returns a partition of (a, b] into half-open intervals that each contain
+// exactly one root counted by chain.
+func isolate_big_roots(chain bigSturmChain, a, b *big.Float, prec uint) [][2]*big.Float {
+
+	c := chain.count(a, b)
+
+	if c == 0 {
+		return [][2]*big.Float{}
+	}
+
+	if c == 1 || bisect_converged(a, b, prec) {
+		return [][2]*big.Float{{a, b}}
+	}
+
+	m := new(big.Float).SetPrec(prec).Add(a, b)
+	m.Quo(m, big.NewFloat(2))
+
+	return append(isolate_big_roots(chain, a, m, prec), isolate_big_roots(chain, m, b, prec)...)
+}
+
+// solve_big_bisect returns the approximated root counted by chain on the interval (left, right].
+func solve_big_bisect(chain bigSturmChain, left, right *big.Float, prec uint) *big.Float {
+
+	left = new(big.Float).SetPrec(prec).Set(left)
+	right = new(big.Float).SetPrec(prec).Set(right)
+	mid := new(big.Float).SetPrec(prec)
+
+	for !bisect_converged(left, right, prec) {
+		mid.Add(left, right)
+		mid.Quo(mid, big.NewFloat(2))
+
+		// The midpoint can no longer be distinguished from an endpoint.
+		if mid.Cmp(left) == 0 || mid.Cmp(right) == 0 {
+			break
+		}
+
+		if chain.count(left, mid) == 1 {
+			right.Set(mid)
+		} else {
+			left.Set(mid)
+		}
+	}
+
+	return mid.Add(left, right).Quo(mid, big.NewFloat(2))
+}
+
+// FindBigRootsWithin returns the distinct roots of p on the half-open interval (a, b], computed to
+// the precision of p.
+//
+// Arbitrary-precision polynomials only support the Sturm/bisection pipeline, so the algorithms
+// the solver is equipped with are not consulted.
+//
+// Panics for invalid intervals and infinite solutions.
+func (s Solver) FindBigRootsWithin(p BigPoly, a, b *big.Float) []*big.Float {
+
+	if b.Cmp(a) < 0 {
+		log.Panicf("FindBigRootsWithin: invalid interval (%s, %s].", a.String(), b.String())
+	}
+
+	if p.IsZero() {
+		log.Panicf("FindBigRootsWithin: infinite solutions for %v.", p)
+	}
+
+	roots := []*big.Float{}
+
+	if p.deg == 0 {
+		return roots
+	}
+
+	chain := new_bigSturmChain(p)
+
+	for _, h := range isolate_big_roots(chain, a, b, p.prec) {
+		roots = append(roots, solve_big_bisect(chain, h[0], h[1], p.prec))
+	}
+
+	return roots
+}
+
+// FindBigRoots returns all distinct roots of p, computed to the precision of p.
+func (s Solver) FindBigRoots(p BigPoly) []*big.Float {
+
+	bound := p.CauchyBound()
+	return s.FindBigRootsWithin(p, new(big.Float).Neg(bound), bound)
+}
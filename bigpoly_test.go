@@ -0,0 +1,122 @@
+package polygo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in bigpoly.go.
+*/
+
+// bigs returns the float64s in s as a slice of *big.Float.
+func bigs(s ...float64) []*big.Float {
+	ret := make([]*big.Float, len(s))
+	for i, v := range s {
+		ret[i] = big.NewFloat(v)
+	}
+	return ret
+}
+
+// ratWilkinson returns Wilkinson's polynomial with exact coefficients.
+func ratWilkinson() RatPoly {
+	w := NewRatPoly(rats(1))
+	for k := int64(1); k <= 20; k++ {
+		w = w.Mul(NewRatPoly(rats(1, -k)))
+	}
+	return w
+}
+
+func Test_NewBigPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewBigPoly([]*big.Float{}, 64) })
+	assert.Panics(t, func() { NewBigPoly(bigs(1), 0) })
+	assert.Panics(t, func() { NewBigPoly([]*big.Float{nil}, 64) })
+	assert.Panics(t, func() { NewBigPolyFromPoly(NewPolyZero(), 0) })
+}
+
+func Test_NewBigPoly(t *testing.T) {
+
+	p := NewBigPoly(bigs(0, 3, -1, 4), 100)
+
+	assert.Equal(t, 2, p.Degree())
+	assert.Equal(t, uint(100), p.Precision())
+	assert.Equal(t, "[ 3x^{2} - 1x^{1} + 4x^{0} ]", p.Text(10))
+	assert.Equal(t, NewPoly([]float64{3, -1, 4}), p.ToPoly())
+	assert.Equal(t, NewPoly([]float64{3, -1, 4}), NewBigPolyFromPoly(NewPoly([]float64{3, -1, 4}), 80).ToPoly())
+}
+
+func Test_BigPolyAt(t *testing.T) {
+
+	p := NewBigPolyFromRatPoly(ratWilkinson(), 256)
+
+	// float64 Horner evaluation of Wilkinson's polynomial is swamped by round-off near x = 19.5,
+	// but 256 bits are plenty.
+	want := ratWilkinson().At(big.NewRat(39, 2))
+	wantf, _ := new(big.Float).SetPrec(256).SetRat(want).Float64()
+	gotf, _ := p.At(big.NewFloat(19.5)).Float64()
+
+	assert.Equal(t, wantf, gotf)
+}
+
+func Test_BigPolyArithmetic(t *testing.T) {
+
+	p := NewBigPoly(bigs(1, 1), 64)
+	q := NewBigPoly(bigs(1, -1), 128)
+
+	assert.Equal(t, NewPoly([]float64{2, 0}), p.Add(q).ToPoly())
+	assert.Equal(t, uint(128), p.Add(q).Precision())
+	assert.Equal(t, NewPoly([]float64{2}), p.Sub(q).ToPoly())
+	assert.True(t, p.Sub(p).IsZero())
+	assert.Equal(t, NewPoly([]float64{1, 0, -1}), p.Mul(q).ToPoly())
+	assert.Equal(t, NewPoly([]float64{3, 2, 1}), NewBigPoly(bigs(1, 1, 1, 1), 64).Derivative().ToPoly())
+	assert.True(t, NewBigPoly(bigs(5), 64).Derivative().IsZero())
+}
+
+func Test_BigPolyDiv(t *testing.T) {
+
+	assert.Panics(t, func() { NewBigPoly(bigs(1), 64).Div(NewBigPoly(bigs(0), 64)) })
+
+	m, n := NewBigPoly(bigs(2, 0, -3, 1), 64).Div(NewBigPoly(bigs(2, 0, 1), 64))
+	assert.Equal(t, NewPoly([]float64{1, 0}), m.ToPoly())
+	assert.Equal(t, NewPoly([]float64{-4, 1}), n.ToPoly())
+
+	m, n = NewBigPoly(bigs(4, 2), 64).Div(NewBigPoly(bigs(2), 64))
+	assert.Equal(t, NewPoly([]float64{2, 1}), m.ToPoly())
+	assert.True(t, n.IsZero())
+}
+
+func Test_BigPolyCountSturm(t *testing.T) {
+
+	p := NewBigPolyFromRatPoly(ratWilkinson(), 256)
+
+	assert.Equal(t, 20, p.CountSturm(big.NewFloat(0), big.NewFloat(21)))
+	assert.Equal(t, 1, p.CountSturm(big.NewFloat(19.5), big.NewFloat(20.5)))
+	assert.Equal(t, 0, NewBigPoly(bigs(1, 0, 1), 64).CountSturm(big.NewFloat(-2), big.NewFloat(2)))
+}
+
+func Test_SolverFindBigRoots(t *testing.T) {
+
+	s := NewSolverDefault()
+
+	assert.Panics(t, func() { s.FindBigRoots(NewBigPoly(bigs(1), 64)) })
+	assert.Panics(t, func() { s.FindBigRootsWithin(NewBigPoly(bigs(0), 64), big.NewFloat(0), big.NewFloat(1)) })
+	assert.Panics(t, func() { s.FindBigRootsWithin(NewBigPoly(bigs(1, 0), 64), big.NewFloat(1), big.NewFloat(0)) })
+
+	// sqrt(2) to 60 digits.
+	roots := s.FindBigRoots(NewBigPoly(bigs(1, 0, -2), 256))
+	assert.Len(t, roots, 2)
+	assert.Equal(t, "1.41421356237309504880168872420969807856967187537694807317668", roots[1].Text('f', 59))
+
+	// The roots of Wilkinson's polynomial are recovered to more than 50 digits.
+	roots = s.FindBigRootsWithin(NewBigPolyFromRatPoly(ratWilkinson(), 256), big.NewFloat(0), big.NewFloat(21))
+	assert.Len(t, roots, 20)
+
+	tol := new(big.Float).SetMantExp(big.NewFloat(1), -170)
+	for i, x := range roots {
+		diff := new(big.Float).Sub(x, big.NewFloat(float64(i+1)))
+		assert.True(t, diff.Abs(diff).Cmp(tol) < 0, "root %d: %s", i+1, x.Text('g', 60))
+	}
+}
@@ -1,21 +1,15 @@
 package polygo
 
+import (
+	"log"
+	"math"
+	"sort"
+)
+
 // Derivative returns the derivative of p.
 func (p Poly) Derivative() Poly {
 
-	// p is a constant, whose derivative is always 0.
-	if p.deg == 0 {
-		return NewPoly([]float64{0})
-	}
-
-	// Formal derivative.
-	// For nonconstant p, if deg(p) = n, then deg(p') = n - 1.
-	derivCoef := make([]float64, p.deg)
-	for i := 0; i < p.deg; i++ {
-		derivCoef[i] = p.coef[i+1] * float64(i+1)
-	}
-
-	return newPolyNoReverse(derivCoef)
+	return NewPolyFromGeneric(p.Generic().Derivative())
 }
 
 // DerivativeN returns the nth derivative of p.
@@ -34,3 +28,68 @@ func (p Poly) DerivativeN(n int) Poly {
 
 	return p
 }
+
+// Integral returns the antiderivative of p with integration constant c.
+func (p Poly) Integral(c float64) Poly {
+
+	// Formal antiderivative.
+	// If deg(p) = n, then the antiderivative has degree n + 1.
+	intCoef := make([]float64, p.len+1)
+	intCoef[0] = c
+	for i := 0; i < p.len; i++ {
+		intCoef[i+1] = p.coef[i] / float64(i+1)
+	}
+
+	return newPolyNoReverse(intCoef)
+}
+
+// IntegrateOver returns the definite integral of p from a to b.
+func (p Poly) IntegrateOver(a, b float64) float64 {
+
+	antideriv := p.Integral(0)
+
+	return antideriv.At(b) - antideriv.At(a)
+}
+
+// AreaBetween returns the (unsigned) area enclosed between p and q on the interval [a, b].
+//
+// The interval is split at the intersections of p and q found by FindIntersectionsWithin(), so
+// that regions where q lies above p do not cancel out regions where p lies above q.
+//
+// Panics for invalid intervals.
+func (s Solver) AreaBetween(p, q Poly, a, b float64) float64 {
+
+	if b < a {
+		log.Panicf("AreaBetween: invalid interval [%f, %f].", a, b)
+	}
+
+	d := p.Sub(q)
+
+	// p and q coincide, so FindIntersectionsWithin() would have infinite solutions.
+	if d.IsZero() {
+		return 0
+	}
+
+	antideriv := d.Integral(0)
+
+	splits := []float64{a}
+	if d.deg > 0 {
+		for _, pt := range s.FindIntersectionsWithin(p, q, a, b) {
+
+			// Low degree differences are solved exactly, without regard for the interval.
+			if a < pt.X && pt.X < b {
+				splits = append(splits, pt.X)
+			}
+		}
+	}
+	splits = append(splits, b)
+
+	sort.Float64s(splits)
+
+	area := 0.0
+	for i := 1; i < len(splits); i++ {
+		area += math.Abs(antideriv.At(splits[i]) - antideriv.At(splits[i-1]))
+	}
+
+	return area
+}
@@ -114,3 +114,122 @@ func Test_PolyDerivativeN(t *testing.T) {
 		})
 	}
 }
+
+func Test_PolyIntegral(t *testing.T) {
+	testCases := []struct {
+		name string
+		argP Poly
+		argC float64
+		want Poly
+	}{
+		{
+			name: "zero",
+			argP: NewPolyZero(),
+			argC: 0,
+			want: NewPolyZero(),
+		},
+		{
+			name: "zero with constant",
+			argP: NewPolyZero(),
+			argC: 2.5,
+			want: NewPolyConst(2.5),
+		},
+		{
+			name: "nonzero const",
+			argP: NewPolyConst(3),
+			argC: 1,
+			want: NewPoly([]float64{3, 1}),
+		},
+		{
+			name: "quadratic",
+			argP: NewPoly([]float64{3, 4, -1}),
+			argC: -7,
+			want: NewPoly([]float64{1, 2, -1, -7}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.argP.Integral(tc.argC)
+
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.argP, got.Derivative())
+		})
+	}
+}
+
+func Test_PolyIntegrateOver(t *testing.T) {
+
+	p := NewPoly([]float64{3, 0, 0})
+
+	assert.Equal(t, 1.0, p.IntegrateOver(0, 1))
+	assert.Equal(t, -1.0, p.IntegrateOver(1, 0))
+	assert.Equal(t, 0.0, NewPoly([]float64{1, 0}).IntegrateOver(-2, 2))
+}
+
+func Test_SolverAreaBetweenPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewSolverDefault().AreaBetween(NewPolyZero(), NewPolyZero(), 1, 0) })
+}
+
+func Test_SolverAreaBetween(t *testing.T) {
+	testCases := []struct {
+		name string
+		argP Poly
+		argQ Poly
+		argA float64
+		argB float64
+		want float64
+	}{
+		{
+			name: "identical",
+			argP: NewPoly([]float64{1, 2, 3}),
+			argQ: NewPoly([]float64{1, 2, 3}),
+			argA: -1,
+			argB: 1,
+			want: 0,
+		},
+		{
+			name: "parallel lines",
+			argP: NewPolyLinear(1, 2),
+			argQ: NewPolyLinear(1, -1),
+			argA: 0,
+			argB: 2,
+			want: 6,
+		},
+		{
+			name: "x against zero on symmetric interval",
+			argP: NewPolyLinear(1, 0),
+			argQ: NewPolyZero(),
+			argA: -2,
+			argB: 2,
+			want: 4,
+		},
+		{
+			name: "crossing outside the interval",
+			argP: NewPoly([]float64{1, 0, 0}),
+			argQ: NewPolyConst(1),
+			argA: 2,
+			argB: 3,
+			want: 16.0 / 3,
+		},
+		{
+			name: "cubic x^3 - x against zero",
+			argP: NewPoly([]float64{1, 0, -1, 0}),
+			argQ: NewPolyZero(),
+			argA: -1,
+			argB: 1,
+			want: 0.5,
+		},
+	}
+
+	s := NewSolverDefault()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := s.AreaBetween(tc.argP, tc.argQ, tc.argA, tc.argB)
+
+			assert.InDelta(t, tc.want, got, 1e-6)
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// A ChebPoly represents a univariate polynomial on an interval [a, b] in the Chebyshev basis.
+//
+// The basis functions are the Chebyshev polynomials of the first kind, T_k(t), composed with the
+// affine map t = (2x - a - b) / (b - a) taking [a, b] onto [-1, 1]. Unlike the monomial basis,
+// this basis is well conditioned on [a, b], so polynomials of high degree may be stored and
+// evaluated accurately.
+//
+// Note: in the documentation for each method of ChebPoly, we refer to the receiver instance as
+// "p".
+type ChebPoly struct {
+	coef []float64
+	len  int
+	deg  int
+	a    float64
+	b    float64
+}
+
+// NewChebPoly returns a polynomial p on [a, b] with the given Chebyshev coefficients.
+//
+// Let c = coefficients, let n = len(c) and let t = (2x - a - b) / (b - a). Then, p is defined by
+//
+//   - p(x) = c[0]T_(n-1)(t) + c[1]T_(n-2)(t) + ... + c[n-2]T_1(t) + c[n-1]T_0(t).
+//
+// # Examples:
+//   - NewChebPoly([]float64{1, 0, 0}, -1, 1) represents p(x) = T_2(x) = 2x^2 - 1.
+//   - NewChebPoly([]float64{1, 0}, 0, 2) represents p(x) = T_1(x - 1) = x - 1.
+//
+// Panics if coefficients slice is empty or if a >= b.
+func NewChebPoly(coefficients []float64, a, b float64) ChebPoly {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewChebPoly: empty coefficients slice.")
+	}
+
+	if !(a < b) {
+		log.Panicf("NewChebPoly: invalid interval [%f, %f].", a, b)
+	}
+
+	// See NewPoly() for why the coefficients are reversed and stripped.
+	return newChebPolyNoReverse(reverse(coefficients), a, b)
+}
+
+// newChebPolyNoReverse is just NewChebPoly but with no coefficient slice reversal.
+//
+// Doesn't do the empty panic and interval checks like in NewChebPoly().
+func newChebPolyNoReverse(coefficients []float64, a, b float64) ChebPoly {
+
+	coefficients = removeTrailingZeroes(coefficients)
+	coefLen := len(coefficients)
+
+	ret := ChebPoly{
+		coef: coefficients,
+		len:  coefLen,
+		deg:  coefLen - 1,
+		a:    a,
+		b:    b,
+	}
+
+	return ret
+}
+
+// NewChebPolyFromPoly returns p as a polynomial in the Chebyshev basis on [a, b].
+//
+// Panics if a >= b.
+func NewChebPolyFromPoly(p Poly, a, b float64) ChebPoly {
+
+	if !(a < b) {
+		log.Panicf("NewChebPolyFromPoly: invalid interval [%f, %f].", a, b)
+	}
+
+	// q(t) = p(x(t)), where x(t) = ((b - a)t + a + b) / 2.
+	q := p.Compose(NewPolyLinear((b-a)/2, (a+b)/2))
+
+	// Horner's scheme in the Chebyshev basis, using
+	// tT_0(t) = T_1(t) and tT_k(t) = (T_(k+1)(t) + T_(k-1)(t)) / 2.
+	c := make([]float64, q.len)
+	c[0] = q.coef[q.deg]
+
+	for i := q.deg - 1; i >= 0; i-- {
+		n := q.deg - i
+
+		// Multiply the degree n - 1 series held in c by t.
+		prod := make([]float64, n+1)
+		for k := 0; k < n; k++ {
+			if k == 0 {
+				prod[1] += c[0]
+			} else {
+				prod[k+1] += c[k] / 2
+				prod[k-1] += c[k] / 2
+			}
+		}
+
+		prod[0] += q.coef[i]
+		copy(c, prod)
+	}
+
+	return newChebPolyNoReverse(c, a, b)
+}
+
+// ToPoly returns p in the monomial basis.
+//
+// Note that the monomial basis is badly conditioned for high degrees, so the result may be much
+// less accurate than p itself.
+func (p ChebPoly) ToPoly() Poly {
+
+	// Clenshaw's recurrence with polynomial arithmetic.
+	t2 := NewPolyLinear(4/(p.b-p.a), -2*(p.a+p.b)/(p.b-p.a))
+
+	b1, b2 := NewPolyZero(), NewPolyZero()
+	for k := p.deg; k >= 1; k-- {
+		b1, b2 = t2.Mul(b1).Sub(b2).Add(NewPolyConst(p.coef[k])), b1
+	}
+
+	return t2.MulScalar(0.5).Mul(b1).Sub(b2).Add(NewPolyConst(p.coef[0]))
+}
+
+// Coefficients returns the Chebyshev coefficients c of p ordered in decreasing degree.
+func (p ChebPoly) Coefficients() []float64 {
+
+	return reverse(p.coef)
+}
+
+// Degree returns the degree of p.
+func (p ChebPoly) Degree() int {
+
+	return p.deg
+}
+
+// Interval returns the interval [a, b] on which p is defined.
+func (p ChebPoly) Interval() (float64, float64) {
+
+	return p.a, p.b
+}
+
+// Equal returns true if the p is equal to q (same interval and all corresponding coefficients are
+// equal), else false.
+func (p ChebPoly) Equal(q ChebPoly) bool {
+
+	if p.deg != q.deg || p.a != q.a || p.b != q.b {
+		return false
+	}
+
+	for i := 0; i < p.len; i++ {
+		if p.coef[i] != q.coef[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZero returns true if p(x) = 0, else false.
+func (p ChebPoly) IsZero() bool {
+
+	return p.deg == 0 && p.coef[0] == 0
+}
+
+// At returns the value of p evaluated at x.
+//
+// x need not lie in [a, b], though the Chebyshev basis loses its good conditioning outside it.
+func (p ChebPoly) At(x float64) float64 {
+
+	t := (2*x - p.a - p.b) / (p.b - p.a)
+
+	// Implement Clenshaw's recurrence.
+	b1, b2 := 0.0, 0.0
+	for k := p.deg; k >= 1; k-- {
+		b1, b2 = p.coef[k]+2*t*b1-b2, b1
+	}
+
+	return p.coef[0] + t*b1 - b2
+}
+
+// checkSameInterval panics if p and q are defined on different intervals.
+func (p ChebPoly) checkSameInterval(caller string, q ChebPoly) {
+
+	if p.a != q.a || p.b != q.b {
+		log.Panicf("%s: mismatched intervals [%f, %f] and [%f, %f].", caller, p.a, p.b, q.a, q.b)
+	}
+}
+
+// Add returns the polynomial sum p + q.
+//
+// Panics if p and q are defined on different intervals.
+func (p ChebPoly) Add(q ChebPoly) ChebPoly {
+
+	p.checkSameInterval("Add", q)
+
+	var max int
+	if p.len > q.len {
+		max = p.len
+	} else {
+		max = q.len
+	}
+
+	pe := expand(p.coef, max)
+	qe := expand(q.coef, max)
+
+	sumCoef := make([]float64, max)
+
+	for i := 0; i < max; i++ {
+		sumCoef[i] = pe[i] + qe[i]
+	}
+
+	return newChebPolyNoReverse(sumCoef, p.a, p.b)
+}
+
+// Sub returns the polynomial difference p - q.
+//
+// Panics if p and q are defined on different intervals.
+func (p ChebPoly) Sub(q ChebPoly) ChebPoly {
+
+	p.checkSameInterval("Sub", q)
+
+	var max int
+	if p.len > q.len {
+		max = p.len
+	} else {
+		max = q.len
+	}
+
+	pe := expand(p.coef, max)
+	qe := expand(q.coef, max)
+
+	difCoef := make([]float64, max)
+
+	for i := 0; i < max; i++ {
+		difCoef[i] = pe[i] - qe[i]
+	}
+
+	return newChebPolyNoReverse(difCoef, p.a, p.b)
+}
+
+// MulScalar returns the scalar-polynomial product sp.
+func (p ChebPoly) MulScalar(s float64) ChebPoly {
+
+	if s == 0 {
+		return newChebPolyNoReverse([]float64{0}, p.a, p.b)
+	}
+
+	prodCoef := make([]float64, p.len)
+	for i, c := range p.coef {
+		prodCoef[i] = s * c
+	}
+
+	return newChebPolyNoReverse(prodCoef, p.a, p.b)
+}
+
+// Mul returns the polynomial product pq.
+//
+// Panics if p and q are defined on different intervals.
+func (p ChebPoly) Mul(q ChebPoly) ChebPoly {
+
+	p.checkSameInterval("Mul", q)
+
+	prodCoef := make([]float64, p.deg+q.deg+1)
+
+	// T_i(t)T_j(t) = (T_(i+j)(t) + T_|i-j|(t)) / 2.
+	for i := 0; i < p.len; i++ {
+		for j := 0; j < q.len; j++ {
+			c := p.coef[i] * q.coef[j] / 2
+
+			prodCoef[i+j] += c
+			if i > j {
+				prodCoef[i-j] += c
+			} else {
+				prodCoef[j-i] += c
+			}
+		}
+	}
+
+	return newChebPolyNoReverse(prodCoef, p.a, p.b)
+}
+
+// Derivative returns the derivative of p.
+func (p ChebPoly) Derivative() ChebPoly {
+
+	if p.deg == 0 {
+		return newChebPolyNoReverse([]float64{0}, p.a, p.b)
+	}
+
+	// d[k - 1] = d[k + 1] + 2kc[k], halving d[0] at the end.
+	derivCoef := make([]float64, p.deg+2)
+	for k := p.deg; k >= 1; k-- {
+		derivCoef[k-1] = derivCoef[k+1] + 2*float64(k)*p.coef[k]
+	}
+	derivCoef[0] /= 2
+
+	// Chain rule for t(x).
+	scale := 2 / (p.b - p.a)
+	for k := range derivCoef {
+		derivCoef[k] *= scale
+	}
+
+	return newChebPolyNoReverse(derivCoef[:p.deg], p.a, p.b)
+}
+
+// Integral returns the antiderivative F of p with F(a) = c.
+func (p ChebPoly) Integral(c float64) ChebPoly {
+
+	coef := expand(p.coef, p.len+2)
+	intCoef := make([]float64, p.len+1)
+
+	// C[1] = c[0] - c[2] / 2 and C[k] = (c[k - 1] - c[k + 1]) / 2k for k > 1.
+	intCoef[1] = coef[0] - coef[2]/2
+	for k := 2; k <= p.len; k++ {
+		intCoef[k] = (coef[k-1] - coef[k+1]) / (2 * float64(k))
+	}
+
+	// Chain rule for t(x).
+	scale := (p.b - p.a) / 2
+	for k := range intCoef {
+		intCoef[k] *= scale
+	}
+
+	// T_k(-1) = (-1)^k, so F(a) = C[0] + sum (-1)^k C[k].
+	atA := 0.0
+	for k := 1; k <= p.len; k++ {
+		if k%2 == 0 {
+			atA += intCoef[k]
+		} else {
+			atA -= intCoef[k]
+		}
+	}
+	intCoef[0] = c - atA
+
+	return newChebPolyNoReverse(intCoef, p.a, p.b)
+}
+
+// IntegrateOver returns the definite integral of p from x1 to x2.
+func (p ChebPoly) IntegrateOver(x1, x2 float64) float64 {
+
+	F := p.Integral(0)
+
+	return F.At(x2) - F.At(x1)
+}
+
+// String returns a string representation of p in decreasing-degree sum form.
+func (p ChebPoly) String() string {
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[ %fT_{%d}", p.coef[p.deg], p.deg))
+
+	for i := 1; i < p.len; i++ {
+		sb.WriteString(fmt.Sprintf(" + %fT_{%d}", p.coef[p.deg-i], p.deg-i))
+	}
+
+	sb.WriteString(fmt.Sprintf(" ] on [%f, %f]", p.a, p.b))
+
+	return sb.String()
+}
@@ -0,0 +1,143 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in chebpoly.go.
+*/
+
+func Test_NewChebPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewChebPoly([]float64{}, -1, 1) })
+	assert.Panics(t, func() { NewChebPoly([]float64{1}, 1, 1) })
+	assert.Panics(t, func() { NewChebPoly([]float64{1}, 1, -1) })
+	assert.Panics(t, func() { NewChebPolyFromPoly(NewPolyConst(1), 2, 0) })
+}
+
+func Test_NewChebPoly(t *testing.T) {
+
+	p := NewChebPoly([]float64{0, 0, 1, 2}, 0, 3)
+
+	assert.Equal(t, []float64{1, 2}, p.Coefficients())
+	assert.Equal(t, 1, p.Degree())
+
+	a, b := p.Interval()
+	assert.Equal(t, 0.0, a)
+	assert.Equal(t, 3.0, b)
+
+	assert.True(t, NewChebPoly([]float64{0, 0}, -1, 1).IsZero())
+	assert.False(t, p.IsZero())
+}
+
+func Test_ChebPolyAt(t *testing.T) {
+
+	// T_n(cos(theta)) = cos(n theta), even for large n.
+	for _, n := range []int{0, 1, 2, 5, 50, 200} {
+		c := make([]float64, n+1)
+		c[0] = 1
+		p := NewChebPoly(c, -1, 1)
+
+		for _, theta := range []float64{0, 0.3, 1, 2.5, math.Pi} {
+			assert.InDelta(t, math.Cos(float64(n)*theta), p.At(math.Cos(theta)), 1e-12)
+		}
+	}
+
+	// T_1 on [0, 2] is x - 1.
+	p := NewChebPoly([]float64{1, 0}, 0, 2)
+	assert.InDelta(t, 0.5, p.At(1.5), 1e-15)
+}
+
+func Test_ChebPolyConversion(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    Poly
+		a, b float64
+	}{
+		{name: "constant", p: NewPolyConst(3), a: -1, b: 1},
+		{name: "T_2", p: NewPolyChebyshev1(2), a: -1, b: 1},
+		{name: "T_7", p: NewPolyChebyshev1(7), a: -1, b: 1},
+		{name: "cubic on [2, 5]", p: NewPoly([]float64{1, -2, 0, 4}), a: 2, b: 5},
+		{name: "Legendre on [-3, 0]", p: NewPolyLegendre(6), a: -3, b: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewChebPolyFromPoly(tc.p, tc.a, tc.b)
+
+			for x := tc.a; x <= tc.b; x += (tc.b - tc.a) / 7 {
+				assert.InDelta(t, tc.p.At(x), c.At(x), 1e-10)
+			}
+
+			back := c.ToPoly()
+			assert.InDeltaSlice(t, tc.p.Coefficients(), back.Coefficients(), 1e-10)
+		})
+	}
+
+	// T_7 in the Chebyshev basis has a single coefficient.
+	c := NewChebPolyFromPoly(NewPolyChebyshev1(7), -1, 1)
+	assert.InDeltaSlice(t, []float64{1, 0, 0, 0, 0, 0, 0, 0}, c.Coefficients(), 1e-12)
+}
+
+func Test_ChebPolyArithmetic(t *testing.T) {
+
+	p := NewPoly([]float64{1, 0, -2, 1})
+	q := NewPoly([]float64{3, 1, 5})
+	a, b := -2.0, 3.0
+
+	cp := NewChebPolyFromPoly(p, a, b)
+	cq := NewChebPolyFromPoly(q, a, b)
+
+	testCases := []struct {
+		name string
+		got  ChebPoly
+		want Poly
+	}{
+		{name: "add", got: cp.Add(cq), want: p.Add(q)},
+		{name: "sub", got: cp.Sub(cq), want: p.Sub(q)},
+		{name: "mul scalar", got: cp.MulScalar(-2.5), want: p.MulScalar(-2.5)},
+		{name: "mul", got: cp.Mul(cq), want: p.Mul(q)},
+		{name: "derivative", got: cp.Derivative(), want: p.Derivative()},
+		{name: "derivative of constant", got: NewChebPoly([]float64{4}, a, b).Derivative(), want: NewPolyZero()},
+		{name: "integral", got: cp.Integral(7), want: p.Integral(0).Add(NewPolyConst(7 - p.Integral(0).At(a)))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for x := a; x <= b; x += 0.5 {
+				assert.InDelta(t, tc.want.At(x), tc.got.At(x), 1e-10)
+			}
+			assert.Equal(t, tc.want.Degree(), tc.got.Degree())
+		})
+	}
+
+	assert.True(t, cp.MulScalar(0).IsZero())
+	assert.InDelta(t, p.IntegrateOver(-1, 2), cp.IntegrateOver(-1, 2), 1e-12)
+	assert.InDelta(t, -2.0/3, NewChebPoly([]float64{1, 0, 0}, -1, 1).IntegrateOver(-1, 1), 1e-12)
+
+	other := NewChebPolyFromPoly(q, a, b+1)
+	assert.Panics(t, func() { cp.Add(other) })
+	assert.Panics(t, func() { cp.Sub(other) })
+	assert.Panics(t, func() { cp.Mul(other) })
+}
+
+func Test_ChebPolyEqual(t *testing.T) {
+
+	p := NewChebPoly([]float64{1, 2}, 0, 1)
+
+	assert.True(t, p.Equal(NewChebPoly([]float64{1, 2}, 0, 1)))
+	assert.False(t, p.Equal(NewChebPoly([]float64{1, 2}, 0, 2)))
+	assert.False(t, p.Equal(NewChebPoly([]float64{1, 3}, 0, 1)))
+	assert.False(t, p.Equal(NewChebPoly([]float64{2}, 0, 1)))
+}
+
+func Test_ChebPolyString(t *testing.T) {
+
+	p := NewChebPoly([]float64{1, -2, 0}, 0, 1)
+
+	assert.Equal(t, "[ 1.000000T_{2} + -2.000000T_{1} + 0.000000T_{0} ] on [0.000000, 1.000000]", p.String())
+}
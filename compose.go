@@ -0,0 +1,62 @@
+package polygo
+
+// Compose returns the polynomial composition p(q(x)).
+func (p Poly) Compose(q Poly) Poly {
+
+	// Implement Horner's scheme with polynomial arithmetic:
+	// p(q) = (...((c[n]q + c[n-1])q + c[n-2])q + ...)q + c[0].
+	out := NewPolyConst(p.coef[p.deg])
+	for i := p.deg - 1; i >= 0; i-- {
+		out = out.Mul(q).Add(NewPolyConst(p.coef[i]))
+	}
+
+	return out
+}
+
+// ComposeFast returns the polynomial composition p(q(x)).
+//
+// This method uses MulFast() for the intermediate products. Be sure to read the documentation for
+// MulFast(), as the behaviour is the same.
+func (p Poly) ComposeFast(q Poly) Poly {
+
+	out := NewPolyConst(p.coef[p.deg])
+	for i := p.deg - 1; i >= 0; i-- {
+		out = out.MulFast(q).Add(NewPolyConst(p.coef[i]))
+	}
+
+	return out
+}
+
+// Shift returns the polynomial p(x + a).
+//
+// Unlike Compose(), no polynomial products are formed, so the Taylor shift is computed in O(n^2)
+// time using only scalar operations.
+func (p Poly) Shift(a float64) Poly {
+
+	if a == 0 {
+		return newPolyNoReverse(append([]float64{}, p.coef...))
+	}
+
+	// Repeated synthetic division by (x - a). After pass i, coef[i] holds the coefficient of x^i
+	// in p(x + a).
+	coef := append([]float64{}, p.coef...)
+
+	for i := 0; i < p.deg; i++ {
+		for j := p.deg - 1; j >= i; j-- {
+			coef[j] += a * coef[j+1]
+		}
+	}
+
+	return newPolyNoReverse(coef)
+}
+
+// ExpandAround returns the coefficients c of p in powers of (x - a) ordered in decreasing degree.
+//
+// Let n = deg(p). Then,
+//
+//   - p(x) = c[0](x - a)^n + c[1](x - a)^(n-1) + ... + c[n-1](x - a)^1 + c[n](x - a)^0.
+func (p Poly) ExpandAround(a float64) []float64 {
+
+	// If q(y) = p(y + a), then p(x) = q(x - a).
+	return p.Shift(a).Coefficients()
+}
@@ -0,0 +1,121 @@
+package polygo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in compose.go.
+*/
+
+func Test_PolyCompose(t *testing.T) {
+	testCases := []struct {
+		name string
+		argP Poly
+		argQ Poly
+		want Poly
+	}{
+		{
+			name: "constant outer",
+			argP: NewPolyConst(5),
+			argQ: NewPoly([]float64{1, 2, 3}),
+			want: NewPolyConst(5),
+		},
+		{
+			name: "constant inner",
+			argP: NewPoly([]float64{1, 2, 3}),
+			argQ: NewPolyConst(2),
+			want: NewPolyConst(11),
+		},
+		{
+			name: "identity",
+			argP: NewPoly([]float64{4, -1, 0, 2}),
+			argQ: NewPolyLinear(1, 0),
+			want: NewPoly([]float64{4, -1, 0, 2}),
+		},
+		{
+			name: "square of shifted",
+			argP: NewPoly([]float64{1, 0, 0}),
+			argQ: NewPolyLinear(1, 1),
+			want: NewPoly([]float64{1, 2, 1}),
+		},
+		{
+			name: "quadratic of quadratic",
+			argP: NewPoly([]float64{1, 0, -1}),
+			argQ: NewPoly([]float64{1, 1, 0}),
+			want: NewPoly([]float64{1, 2, 1, 0, -1}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.argP.Compose(tc.argQ))
+
+			fast := tc.argP.ComposeFast(tc.argQ)
+			assert.Equal(t, tc.want.Degree(), fast.Degree())
+			for i := range tc.want.coef {
+				assert.InDelta(t, tc.want.coef[i], fast.coef[i], 1e-9)
+			}
+		})
+	}
+}
+
+func Test_PolyShift(t *testing.T) {
+	testCases := []struct {
+		name string
+		argP Poly
+		argA float64
+		want Poly
+	}{
+		{
+			name: "zero shift",
+			argP: NewPoly([]float64{1, 2, 3}),
+			argA: 0,
+			want: NewPoly([]float64{1, 2, 3}),
+		},
+		{
+			name: "constant",
+			argP: NewPolyConst(7),
+			argA: 3,
+			want: NewPolyConst(7),
+		},
+		{
+			name: "square",
+			argP: NewPoly([]float64{1, 0, 0}),
+			argA: 1,
+			want: NewPoly([]float64{1, 2, 1}),
+		},
+		{
+			name: "cubic",
+			argP: NewPoly([]float64{1, 0, 0, 0}),
+			argA: -2,
+			want: NewPoly([]float64{1, -6, 12, -8}),
+		},
+		{
+			name: "agrees with compose",
+			argP: NewPoly([]float64{3, -1, 4, 1, -5}),
+			argA: 2,
+			want: NewPoly([]float64{3, -1, 4, 1, -5}).Compose(NewPolyLinear(1, 2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.argP.Shift(tc.argA))
+		})
+	}
+}
+
+func Test_PolyExpandAround(t *testing.T) {
+
+	// x^2 = (x - 1)^2 + 2(x - 1) + 1.
+	assert.Equal(t, []float64{1, 2, 1}, NewPoly([]float64{1, 0, 0}).ExpandAround(1))
+
+	// 2x^3 - x + 5 around -1.
+	p := NewPoly([]float64{2, 0, -1, 5})
+	c := p.ExpandAround(-1)
+	assert.Equal(t, []float64{2, -6, 5, 4}, c)
+	assert.Equal(t, p, NewPoly(c).Compose(NewPolyLinear(1, 1)))
+}
@@ -0,0 +1,418 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"sort"
+)
+
+// RatInterval represents a half-open interval (L, R] with exact rational end points.
+type RatInterval struct {
+	L, R *big.Rat
+}
+
+// HalfOpenInterval returns h with L rounded down and R rounded up to a float64, so that the result
+// contains h.
+func (h RatInterval) HalfOpenInterval() HalfOpenInterval {
+
+	l, _ := h.L.Float64()
+	if new(big.Rat).SetFloat64(l).Cmp(h.L) > 0 {
+		l = math.Nextafter(l, math.Inf(-1))
+	}
+
+	r, _ := h.R.Float64()
+	if new(big.Rat).SetFloat64(r).Cmp(h.R) < 0 {
+		r = math.Nextafter(r, math.Inf(1))
+	}
+
+	return HalfOpenInterval{l, r}
+}
+
+// String returns a string representation of h.
+func (h RatInterval) String() string {
+
+	return fmt.Sprintf("(%s, %s]", h.L.RatString(), h.R.RatString())
+}
+
+// A mobius represents the Mobius transformation x -> (ax + b) / (cx + d), with c, d > 0, which maps
+// (0, inf) onto the interval between b / d and a / c.
+type mobius struct {
+	a, b, c, d *big.Int
+}
+
+// at0 returns the image b / d of 0 under m.
+func (m mobius) at0() *big.Rat {
+
+	return new(big.Rat).SetFrac(m.b, m.d)
+}
+
+// atInf returns the image a / c of inf under m.
+func (m mobius) atInf() *big.Rat {
+
+	return new(big.Rat).SetFrac(m.a, m.c)
+}
+
+// interval returns the image of (0, inf) under m, as an open interval (L, R).
+func (m mobius) interval() RatInterval {
+
+	l, r := m.at0(), m.atInf()
+	if l.Cmp(r) > 0 {
+		l, r = r, l
+	}
+
+	return RatInterval{l, r}
+}
+
+// shift returns m composed with x -> x + s.
+func (m mobius) shift(s *big.Int) mobius {
+
+	return mobius{
+		a: m.a,
+		b: new(big.Int).Add(new(big.Int).Mul(m.a, s), m.b),
+		c: m.c,
+		d: new(big.Int).Add(new(big.Int).Mul(m.c, s), m.d),
+	}
+}
+
+// invert returns m composed with x -> 1 / (x + 1), which maps (0, inf) onto (0, 1).
+func (m mobius) invert() mobius {
+
+	return mobius{
+		a: m.b,
+		b: new(big.Int).Add(m.a, m.b),
+		c: m.d,
+		d: new(big.Int).Add(m.c, m.d),
+	}
+}
+
+// intPolyFromRatPoly returns the coefficients, in increasing degree, of an integer multiple of p.
+func intPolyFromRatPoly(p RatPoly) []*big.Int {
+
+	den := big.NewInt(1)
+	for _, c := range p.coef {
+		gcd := new(big.Int).GCD(nil, nil, den, c.Denom())
+		den.Mul(den, new(big.Int).Quo(c.Denom(), gcd))
+	}
+
+	coef := make([]*big.Int, p.len)
+	for i, c := range p.coef {
+		coef[i] = new(big.Int).Mul(c.Num(), new(big.Int).Quo(den, c.Denom()))
+	}
+
+	return coef
+}
+
+// shiftInt returns the coefficients of P(x + s), where P has the given coefficients in increasing
+// degree.
+func shiftInt(P []*big.Int, s *big.Int) []*big.Int {
+
+	coef := make([]*big.Int, len(P))
+	for i, c := range P {
+		coef[i] = new(big.Int).Set(c)
+	}
+
+	tmp := new(big.Int)
+	n := len(P) - 1
+
+	for i := 0; i < n; i++ {
+		for j := n - 1; j >= i; j-- {
+			coef[j].Add(coef[j], tmp.Mul(s, coef[j+1]))
+		}
+	}
+
+	return coef
+}
+
+// reverseInt returns the coefficients of x^n P(1 / x), where n = deg(P).
+func reverseInt(P []*big.Int) []*big.Int {
+
+	n := len(P)
+	coef := make([]*big.Int, n)
+	for i, c := range P {
+		coef[n-i-1] = c
+	}
+
+	return coef
+}
+
+// mobiusInt returns the coefficients of (cx + d)^n P((ax + b) / (cx + d)), where n = deg(P), with
+// any vanishing leading coefficients removed.
+func mobiusInt(P []*big.Int, m mobius) []*big.Int {
+
+	n := len(P) - 1
+
+	mul := func(u []*big.Int, a, b *big.Int) []*big.Int {
+		w := make([]*big.Int, len(u)+1)
+		for i := range w {
+			w[i] = new(big.Int)
+		}
+		for i, c := range u {
+			w[i].Add(w[i], new(big.Int).Mul(b, c))
+			w[i+1].Add(w[i+1], new(big.Int).Mul(a, c))
+		}
+		return w
+	}
+
+	// num[k] = (ax + b)^k.
+	num := make([][]*big.Int, n+1)
+	num[0] = []*big.Int{big.NewInt(1)}
+	for k := 1; k <= n; k++ {
+		num[k] = mul(num[k-1], m.a, m.b)
+	}
+
+	coef := make([]*big.Int, n+1)
+	for i := range coef {
+		coef[i] = new(big.Int)
+	}
+
+	// Accumulate P[k](ax + b)^k (cx + d)^(n - k), from k = n down to 0.
+	den := []*big.Int{big.NewInt(1)}
+	for k := n; k >= 0; k-- {
+		term := num[k]
+		prod := make([]*big.Int, len(term)+len(den)-1)
+		for i := range prod {
+			prod[i] = new(big.Int)
+		}
+		for i, u := range term {
+			for j, v := range den {
+				prod[i+j].Add(prod[i+j], new(big.Int).Mul(u, v))
+			}
+		}
+		for i, c := range prod {
+			coef[i].Add(coef[i], new(big.Int).Mul(P[k], c))
+		}
+		den = mul(den, m.c, m.d)
+	}
+
+	for len(coef) > 1 && coef[len(coef)-1].Sign() == 0 {
+		coef = coef[:len(coef)-1]
+	}
+
+	return coef
+}
+
+// signVariationsInt returns the number of sign changes in P, ignoring zeroes.
+func signVariationsInt(P []*big.Int) int {
+
+	v, last := 0, 0
+	for _, c := range P {
+		if s := c.Sign(); s != 0 {
+			if last != 0 && s != last {
+				v++
+			}
+			last = s
+		}
+	}
+
+	return v
+}
+
+// logAbsInt returns log|x| for nonzero x, without overflow.
+func logAbsInt(x *big.Int) float64 {
+
+	mant := new(big.Float)
+	exp := new(big.Float).SetInt(x).MantExp(mant)
+	m, _ := mant.Float64()
+
+	return math.Log(math.Abs(m)) + float64(exp)*ln2
+}
+
+// cf_lower_bound returns an integer lower bound (possibly 0) on the positive roots of P, whose
+// constant coefficient is nonzero.
+//
+// The positive roots of x^n P(1 / x) are bounded above by Kioustelidis' bound
+// 2 max (-r_k / r_n)^(1 / (n - k)) over its negative coefficients r_k, after making the leading
+// coefficient r_n positive. Its reciprocal bounds the positive roots of P below.
+func cf_lower_bound(P []*big.Int) *big.Int {
+
+	R := reverseInt(P)
+	n := len(R) - 1
+
+	lead := R[n].Sign()
+	logLead := logAbsInt(R[n])
+
+	logBound := math.Inf(-1)
+	for k := 0; k < n; k++ {
+		if R[k].Sign() == -lead {
+			logBound = math.Max(logBound, (logAbsInt(R[k])-logLead)/float64(n-k))
+		}
+	}
+
+	// A safety margin for the rounding errors in the logarithms.
+	lb := math.Exp(-logBound) / 2 * 0.99
+
+	if math.IsInf(logBound, -1) || lb < 1 {
+		return big.NewInt(0)
+	}
+
+	s, _ := new(big.Float).SetFloat64(math.Floor(lb)).Int(nil)
+
+	return s
+}
+
+// cf_isolate appends to roots the exact roots of P, and to intervals the open isolating intervals
+// of its other roots, on (0, inf), mapped through m.
+//
+// P must be square-free. Vincent's theorem guarantees that the continued fraction expansion of
+// the roots separates them after finitely many steps, each one a shift by an integer lower bound
+// (see cf_lower_bound()) or a split into (0, 1) and (1, inf).
+func cf_isolate(P []*big.Int, m mobius, roots *[]*big.Rat, intervals *[]RatInterval) {
+
+	for {
+		// An exact root at 0.
+		if P[0].Sign() == 0 {
+			*roots = append(*roots, m.at0())
+			P = P[1:]
+		}
+
+		switch signVariationsInt(P) {
+		case 0:
+			return
+		case 1:
+			*intervals = append(*intervals, m.interval())
+			return
+		}
+
+		if s := cf_lower_bound(P); s.Sign() > 0 {
+			P = shiftInt(P, s)
+			m = m.shift(s)
+
+			if P[0].Sign() == 0 {
+				continue
+			}
+		}
+
+		// The roots on (0, 1), where the exact root at 1 (if any) is left to the other branch.
+		Q := shiftInt(reverseInt(P), big.NewInt(1))
+		if Q[0].Sign() == 0 {
+			Q = Q[1:]
+		}
+		cf_isolate(Q, m.invert(), roots, intervals)
+
+		// The roots on (1, inf).
+		P = shiftInt(P, big.NewInt(1))
+		m = m.shift(big.NewInt(1))
+	}
+}
+
+// descartesRat returns the number of sign variations of the polynomial P transformed so that
+// (0, inf) maps onto (l, r), which bounds the number of roots of P on (l, r).
+func descartesRat(P []*big.Int, l, r *big.Rat) int {
+
+	m := mobius{
+		a: new(big.Int).Mul(r.Num(), l.Denom()),
+		b: new(big.Int).Mul(l.Num(), r.Denom()),
+		c: new(big.Int).Mul(l.Denom(), r.Denom()),
+		d: new(big.Int).Mul(l.Denom(), r.Denom()),
+	}
+
+	return signVariationsInt(mobiusInt(P, m))
+}
+
+// IsolateRootsCF returns a sequence of non-overlapping half-open intervals (L, R] with exact
+// rational end points, ordered from left to right, each containing exactly one distinct root of p
+// on (a, b].
+//
+// The continued fraction method of Akritas is used on the square-free part of p, computed exactly.
+// All arithmetic is carried out on integers of arbitrary size, so the result is certified for every
+// p, since each float64 coefficient is a rational number. The end points are usually simple
+// fractions (convergents of the continued fraction expansions of the roots), and a rational root
+// met as an end point along the way is returned exactly, as R.
+//
+// Panics if p is identically zero.
+func (p Poly) IsolateRootsCF(a, b float64) []RatInterval {
+
+	if p.IsZero() {
+		log.Panic("IsolateRootsCF: zero polynomial.")
+	}
+
+	if !(a < b) || p.deg == 0 {
+		return []RatInterval{}
+	}
+
+	rp := NewRatPolyFromPoly(p)
+	rp, _ = rp.Div(rp.GCD(rp.Derivative()))
+
+	P := intPolyFromRatPoly(rp)
+
+	ra, rb := new(big.Rat).SetFloat64(a), new(big.Rat).SetFloat64(b)
+
+	// Map (0, inf) onto (a, b) with x -> (bx + a) / (x + 1), scaled to integers.
+	m := mobius{
+		a: new(big.Int).Mul(rb.Num(), ra.Denom()),
+		b: new(big.Int).Mul(ra.Num(), rb.Denom()),
+		c: new(big.Int).Mul(ra.Denom(), rb.Denom()),
+		d: new(big.Int).Mul(ra.Denom(), rb.Denom()),
+	}
+
+	roots := []*big.Rat{}
+	open := []RatInterval{}
+
+	Q := mobiusInt(P, m)
+
+	// A root at a is excluded, and a root at b (where the degree of Q drops) is added separately.
+	if Q[0].Sign() == 0 {
+		Q = Q[1:]
+	}
+
+	if len(Q) > 1 {
+		cf_isolate(Q, m, &roots, &open)
+	}
+
+	if rp.At(rb).Sign() == 0 {
+		roots = append(roots, rb)
+	}
+
+	isRoot := func(x *big.Rat) bool {
+		for _, r := range roots {
+			if r.Cmp(x) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Close each open interval on the right, first shrinking it if its right end is a root.
+	isolated := []RatInterval{}
+	half := big.NewRat(1, 2)
+
+	for _, h := range open {
+		l, r := h.L, h.R
+
+		for isRoot(r) {
+			mid := new(big.Rat).Mul(new(big.Rat).Add(l, r), half)
+
+			if rp.At(mid).Sign() == 0 || descartesRat(P, l, mid) == 1 {
+				r = mid
+				break
+			}
+
+			l = mid
+		}
+
+		isolated = append(isolated, RatInterval{l, r})
+	}
+
+	// Give each exact root the largest interval free of other roots.
+	for _, x := range roots {
+		l := ra
+		for _, h := range isolated {
+			if h.R.Cmp(x) < 0 && h.R.Cmp(l) > 0 {
+				l = h.R
+			}
+		}
+		for _, y := range roots {
+			if y.Cmp(x) < 0 && y.Cmp(l) > 0 {
+				l = y
+			}
+		}
+
+		isolated = append(isolated, RatInterval{l, x})
+	}
+
+	sort.Slice(isolated, func(i, j int) bool { return isolated[i].R.Cmp(isolated[j].R) < 0 })
+
+	return isolated
+}
@@ -0,0 +1,164 @@
+package polygo
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in contfrac.go.
+*/
+
+// intPoly returns the big.Int coefficients of the given integers.
+func intPoly(c ...int64) []*big.Int {
+
+	P := make([]*big.Int, len(c))
+	for i, v := range c {
+		P[i] = big.NewInt(v)
+	}
+
+	return P
+}
+
+// intPolyString returns the decimal strings of the coefficients in P, for comparisons.
+func intPolyString(P []*big.Int) []string {
+
+	s := make([]string, len(P))
+	for i, c := range P {
+		s[i] = c.String()
+	}
+
+	return s
+}
+
+func Test_RatInterval(t *testing.T) {
+
+	h := RatInterval{big.NewRat(1, 3), big.NewRat(1, 2)}
+
+	assert.Equal(t, HalfOpenInterval{1. / 3, 0.5}, h.HalfOpenInterval())
+
+	// The nearest float64 to 1/3 lies below it, so R is rounded up past it, and L down for -1/3.
+	third := RatInterval{big.NewRat(-1, 3), big.NewRat(1, 3)}.HalfOpenInterval()
+	assert.Equal(t, math.Nextafter(1./3, 1), third.R)
+	assert.Equal(t, math.Nextafter(-1./3, -1), third.L)
+	assert.Equal(t, "(1/3, 1/2]", h.String())
+}
+
+func Test_intPolyHelpers(t *testing.T) {
+
+	// 1 - 3x + 2x^2.
+	P := intPoly(1, -3, 2)
+
+	assert.Equal(t, []string{"0", "1", "2"}, intPolyString(shiftInt(P, big.NewInt(1))))
+	assert.Equal(t, []string{"2", "-3", "1"}, intPolyString(reverseInt(P)))
+	assert.Equal(t, 2, signVariationsInt(intPoly(1, 0, -3, 0, 2)))
+	assert.Equal(t, []string{"1", "-3", "2"}, intPolyString(P))
+
+	// x -> (2x + 1) / (x + 1) gives (x + 1)^2 - 3(2x + 1)(x + 1) + 2(2x + 1)^2 = 3x^2 + x.
+	m := mobius{big.NewInt(2), big.NewInt(1), big.NewInt(1), big.NewInt(1)}
+	assert.Equal(t, []string{"0", "1", "3"}, intPolyString(mobiusInt(P, m)))
+
+	// Vanishing leading coefficients are removed: x -> 1 / (x + 1) on 1 - x gives x.
+	m = mobius{big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(1)}
+	assert.Equal(t, []string{"0", "1"}, intPolyString(mobiusInt(intPoly(1, -1), m)))
+
+	c := RatPoly{coef: []*big.Rat{big.NewRat(1, 2), big.NewRat(-2, 3)}, len: 2, deg: 1}
+	assert.Equal(t, []string{"3", "-4"}, intPolyString(intPolyFromRatPoly(c)))
+
+	assert.InDelta(t, math.Log(12345), logAbsInt(big.NewInt(-12345)), 1e-12)
+}
+
+func Test_cf_lower_bound(t *testing.T) {
+
+	// Roots 10, 20 and 30.
+	P := intPoly(-6000, 1100, -60, 1)
+	s := cf_lower_bound(P)
+
+	assert.True(t, s.Sign() > 0)
+	assert.True(t, s.Cmp(big.NewInt(10)) <= 0)
+
+	// A root below 1.
+	assert.Equal(t, 0, cf_lower_bound(intPoly(-1, 3)).Sign())
+}
+
+func Test_PolyIsolateRootsCFPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyZero().IsolateRootsCF(0, 1) })
+}
+
+func Test_PolyIsolateRootsCF(t *testing.T) {
+	testCases := []struct {
+		name  string
+		p     Poly
+		a, b  float64
+		roots []float64
+	}{
+		{name: "constant", p: NewPolyConst(2), a: -1, b: 1, roots: []float64{}},
+		{name: "empty interval", p: NewPolyLinear(1, 0), a: 1, b: 1, roots: []float64{}},
+		{name: "no real roots", p: NewPolyQuadratic(1, 0, 1), a: -10, b: 10, roots: []float64{}},
+		{name: "sqrt 2", p: NewPolyQuadratic(1, 0, -2), a: -2, b: 2,
+			roots: []float64{-math.Sqrt2, math.Sqrt2}},
+		{name: "rational roots", p: NewPolyFactored(6, []float64{0.5, -1. / 3, 2}), a: -1, b: 3,
+			roots: []float64{-1. / 3, 0.5, 2}},
+		{name: "left end excluded", p: NewPolyFactored(1, []float64{-1, 0, 3}), a: -1, b: 4,
+			roots: []float64{0, 3}},
+		{name: "right end included", p: NewPolyFactored(1, []float64{-1, 0, 3}), a: -2, b: 3,
+			roots: []float64{-1, 0, 3}},
+		{name: "multiple roots", p: NewPolyFactored(1, []float64{-2, -2, -2, 1, 1, 5}), a: -10, b: 10,
+			roots: []float64{-2, 1, 5}},
+		{name: "Wilkinson", p: NewPolyWilkinson(), a: 0.5, b: 20.5,
+			roots: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}},
+		{name: "Chebyshev", p: NewPolyChebyshev1(12), a: -1, b: 1,
+			roots: []float64{
+				math.Cos(23 * math.Pi / 24), math.Cos(21 * math.Pi / 24), math.Cos(19 * math.Pi / 24),
+				math.Cos(17 * math.Pi / 24), math.Cos(15 * math.Pi / 24), math.Cos(13 * math.Pi / 24),
+				math.Cos(11 * math.Pi / 24), math.Cos(9 * math.Pi / 24), math.Cos(7 * math.Pi / 24),
+				math.Cos(5 * math.Pi / 24), math.Cos(3 * math.Pi / 24), math.Cos(math.Pi / 24)}},
+		// (1000x - 1)(1000x - 2)(1000x - 3), with exact integer coefficients.
+		{name: "close roots", p: NewPoly([]float64{1e9, -6e6, 11000, -6}), a: 0, b: 2000,
+			roots: []float64{0.001, 0.002, 0.003}},
+		{name: "large root", p: NewPolyFactored(1, []float64{-1e6, 3.5, 1e6 + 0.5}), a: -2e6, b: 2e6,
+			roots: []float64{-1e6, 3.5, 1e6 + 0.5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.p.IsolateRootsCF(tc.a, tc.b)
+
+			assert.Len(t, got, len(tc.roots))
+
+			for i, h := range got {
+				assert.True(t, h.L.Cmp(h.R) < 0, "%v", h)
+				if i > 0 {
+					assert.True(t, got[i-1].R.Cmp(h.L) <= 0, "%v %v", got[i-1], h)
+				}
+
+				if i < len(tc.roots) {
+					hf := h.HalfOpenInterval()
+					assert.True(t, hf.L < tc.roots[i]+1e-12 && tc.roots[i] <= hf.R+1e-12, "%v %v", h, tc.roots[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_SolverIsolateCF(t *testing.T) {
+
+	s := NewSolver(ALG_COUNT_STURM_EXACT, ALG_ISOLATE_CF, ALG_SEARCH_BISECT)
+	p := NewPolyFactored(1, []float64{-2, -0.5, 1, 1.5, 3}).Mul(NewPolyQuadratic(1, 0, -2))
+
+	want := []float64{-2, -math.Sqrt2, -0.5, 1, math.Sqrt2, 1.5, 3}
+
+	got := s.IsolateRootsWithin(p, -5, 5)
+	assert.Len(t, got, len(want))
+	for i, h := range got {
+		assert.True(t, h.L < want[i] && want[i] <= h.R, "%v %v", h, want[i])
+	}
+
+	assert.InDeltaSlice(t, want, s.FindRootsWithin(p, -5, 5), 1e-5)
+	assert.Empty(t, s.IsolateRootsWithin(NewPolyZero(), -1, 1))
+	assert.Equal(t, "ALG_ISOLATE_CF", ALG_ISOLATE_CF.String())
+}
@@ -0,0 +1,266 @@
+package polygo
+
+import (
+	"log"
+
+	"github.com/mjibson/go-dsp/fft"
+)
+
+// A CPoly represents a univariate polynomial with complex coefficients.
+//
+// Note: in the documentation for each method of CPoly, we refer to the receiver instance as "p".
+type CPoly struct {
+	coef []complex128
+	len  int
+	deg  int
+}
+
+// NewCPoly returns a complex polynomial p with the given coefficients.
+//
+// Let c = coefficients and let n = len(c). Then, p is defined by
+//
+//   - p(x) = c[0]x^(n-1) + c[1]x^(n-2) + ... + c[n-2]x^1 + c[n-1]x^0.
+//
+// # Examples:
+//   - NewCPoly([]complex128{1i, 2}) represents p(x) = ix + 2.
+//   - NewCPoly([]complex128{0}) represents p(x) = 0.
+//
+// Panics if coefficients slice is empty.
+func NewCPoly(coefficients []complex128) CPoly {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewCPoly: empty coefficients slice.")
+	}
+
+	// See NewPoly() for why the coefficients are reversed and stripped.
+	return newCPolyNoReverse(reverseComplex(coefficients))
+}
+
+// newCPolyNoReverse is just NewCPoly but with no coefficient slice reversal.
+//
+// Doesn't do the empty panic check like in NewCPoly().
+func newCPolyNoReverse(coefficients []complex128) CPoly {
+
+	coefficients = removeTrailingZeroesComplex(coefficients)
+	coefLen := len(coefficients)
+
+	ret := CPoly{
+		coef: coefficients,
+		len:  coefLen,
+		deg:  coefLen - 1,
+	}
+
+	return ret
+}
+
+// NewCPolyConst returns the complex polynomial p(x) = a.
+func NewCPolyConst(a complex128) CPoly {
+
+	return newCPolyNoReverse([]complex128{a})
+}
+
+// NewCPolyZero returns the complex polynomial p(x) = 0.
+func NewCPolyZero() CPoly {
+
+	return NewCPolyConst(0)
+}
+
+// NewCPolyFactored returns the complex polynomial
+//
+// p(x) = a(x - r[0])(x - r[1])...(x - r[n - 1]),
+//
+// where n = len(r).
+//
+// Panics for empty r.
+func NewCPolyFactored(a complex128, r []complex128) CPoly {
+
+	if len(r) == 0 {
+		log.Panic("NewCPolyFactored: empty r.")
+	}
+
+	if a == 0 {
+		return NewCPolyZero()
+	}
+
+	prod := newCPolyNoReverse([]complex128{-r[0], 1})
+
+	r = r[1:]
+
+	for _, b := range r {
+		prod = prod.Mul(newCPolyNoReverse([]complex128{-b, 1}))
+	}
+
+	return prod.MulScalar(a)
+}
+
+// ToCPoly returns p as a complex polynomial.
+func (p Poly) ToCPoly() CPoly {
+
+	return newCPolyNoReverse(toComplex128(p.coef))
+}
+
+// ToPoly returns the real polynomial formed by the real parts of the coefficients of p.
+//
+// The imaginary parts are discarded.
+func (p CPoly) ToPoly() Poly {
+
+	return newPolyNoReverse(toFloat64(p.coef))
+}
+
+// IsReal returns true if every coefficient of p has zero imaginary part, else false.
+func (p CPoly) IsReal() bool {
+
+	for _, c := range p.coef {
+		if imag(c) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Coefficients returns the coefficients c of p ordered in decreasing degree.
+func (p CPoly) Coefficients() []complex128 {
+
+	return reverseComplex(p.coef)
+}
+
+// Degree returns the degree of p.
+func (p CPoly) Degree() int {
+
+	return p.deg
+}
+
+// LeadingCoefficient returns the coefficient of the highest-degreed term in p.
+func (p CPoly) LeadingCoefficient() complex128 {
+
+	return p.coef[p.deg]
+}
+
+// CoefficientWithDegree returns the coefficient of the term with degree n in p.
+func (p CPoly) CoefficientWithDegree(n uint) complex128 {
+
+	if n > uint(p.deg) {
+		return 0
+	}
+
+	return p.coef[n]
+}
+
+// Equal returns true if the p is equal to q (all corresponding coefficients are equal), else false.
+func (p CPoly) Equal(q CPoly) bool {
+
+	if p.deg != q.deg {
+		return false
+	}
+
+	for i := 0; i < p.len; i++ {
+		if p.coef[i] != q.coef[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZero returns true if p(x) = 0, else false.
+func (p CPoly) IsZero() bool {
+
+	return p.deg == 0 && p.coef[0] == 0
+}
+
+// At returns the value of p evaluated at z.
+func (p CPoly) At(z complex128) complex128 {
+
+	return complex128(p.Generic().At(Complex(z)))
+}
+
+// Add returns the polynomial sum p + q.
+func (p CPoly) Add(q CPoly) CPoly {
+
+	return NewCPolyFromGeneric(p.Generic().Add(q.Generic()))
+}
+
+// Sub returns the polynomial difference p - q.
+func (p CPoly) Sub(q CPoly) CPoly {
+
+	return NewCPolyFromGeneric(p.Generic().Sub(q.Generic()))
+}
+
+// MulScalar returns the scalar-polynomial product sp.
+func (p CPoly) MulScalar(s complex128) CPoly {
+
+	if s == 0 {
+		return NewCPolyZero()
+	}
+
+	return NewCPolyFromGeneric(p.Generic().MulScalar(Complex(s)))
+}
+
+// Mul returns the polynomial product pq.
+func (p CPoly) Mul(q CPoly) CPoly {
+
+	return NewCPolyFromGeneric(p.Generic().Mul(q.Generic()))
+}
+
+// MulFast returns the polynomial product pq.
+//
+// Be sure to read the documentation for Poly.MulFast(), as the behaviour is the same.
+func (p CPoly) MulFast(q CPoly) CPoly {
+
+	if p.deg == 0 {
+		return q.MulScalar(p.coef[0])
+	}
+
+	if q.deg == 0 {
+		return p.MulScalar(q.coef[0])
+	}
+
+	prodlen := p.deg + q.deg + 1
+	potlen := nextPOT(prodlen)
+
+	a := fft.FFT(expandComplex(p.coef, potlen))
+	b := fft.FFT(expandComplex(q.coef, potlen))
+
+	c := make([]complex128, potlen)
+	for i := 0; i < potlen; i++ {
+		c[i] = a[i] * b[i]
+	}
+
+	// Cut off at the expected product length (see Poly.MulFast()).
+	return newCPolyNoReverse(fft.IFFT(c)[:prodlen])
+}
+
+// Pow returns the polynomial power p^n.
+//
+// Panics for negative n.
+func (p CPoly) Pow(n int) CPoly {
+
+	return NewCPolyFromGeneric(p.Generic().Pow(n))
+}
+
+// Div returns m (polynomial quotient) and n (polynomial remainder) such that p/q = m + n/q.
+//
+// Panics if q = 0.
+func (p CPoly) Div(q CPoly) (CPoly, CPoly) {
+
+	if q.IsZero() {
+		log.Panic("Div: division by zero polynomial.")
+	}
+
+	m, n := GenericDiv(p.Generic(), q.Generic())
+
+	return NewCPolyFromGeneric(m), NewCPolyFromGeneric(n)
+}
+
+// Derivative returns the derivative of p.
+func (p CPoly) Derivative() CPoly {
+
+	return NewCPolyFromGeneric(p.Generic().Derivative())
+}
+
+// String returns a string representation of p in decreasing-degree sum form.
+func (p CPoly) String() string {
+
+	return p.Generic().String()
+}
@@ -0,0 +1,147 @@
+package polygo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in cpoly.go.
+*/
+
+func Test_NewCPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewCPoly([]complex128{}) })
+	assert.Panics(t, func() { NewCPolyFactored(1, []complex128{}) })
+}
+
+func Test_NewCPoly(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		arg       []complex128
+		wantCoefs []complex128
+		wantLen   int
+		wantDeg   int
+	}{
+		{
+			name:      "zero",
+			arg:       []complex128{0},
+			wantCoefs: []complex128{0},
+			wantLen:   1,
+			wantDeg:   0,
+		},
+		{
+			name:      "linear",
+			arg:       []complex128{1i, 2},
+			wantCoefs: []complex128{2, 1i},
+			wantLen:   2,
+			wantDeg:   1,
+		},
+		{
+			name:      "redundant zeroes",
+			arg:       []complex128{0, 0, 1 - 1i, 0, 3},
+			wantCoefs: []complex128{3, 0, 1 - 1i},
+			wantLen:   3,
+			wantDeg:   2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewCPoly(tc.arg)
+
+			assert.Equal(t, tc.wantCoefs, got.coef)
+			assert.Equal(t, tc.wantLen, got.len)
+			assert.Equal(t, tc.wantDeg, got.deg)
+		})
+	}
+}
+
+func Test_NewCPolyFactored(t *testing.T) {
+
+	// (x - i)(x + i) = x^2 + 1.
+	got := NewCPolyFactored(1, []complex128{1i, -1i})
+	assert.Equal(t, []complex128{1, 0, 1}, got.coef)
+
+	assert.True(t, NewCPolyFactored(0, []complex128{1i}).IsZero())
+}
+
+func Test_CPolyConversions(t *testing.T) {
+
+	p := NewPoly([]float64{3, -1, 4})
+	c := p.ToCPoly()
+
+	assert.Equal(t, []complex128{4, -1, 3}, c.coef)
+	assert.True(t, c.IsReal())
+	assert.Equal(t, p, c.ToPoly())
+
+	d := NewCPoly([]complex128{1 + 2i, 3})
+	assert.False(t, d.IsReal())
+	assert.Equal(t, NewPoly([]float64{1, 3}), d.ToPoly())
+}
+
+func Test_CPolyAt(t *testing.T) {
+
+	p := NewCPoly([]complex128{1, 0, 1})
+
+	assert.Equal(t, complex(0, 0), p.At(1i))
+	assert.Equal(t, complex(1, 2), p.At(1+1i))
+}
+
+func Test_CPolyArithmetic(t *testing.T) {
+
+	p := NewCPoly([]complex128{1i, 1})
+	q := NewCPoly([]complex128{1, -1i})
+
+	assert.Equal(t, NewCPoly([]complex128{1 + 1i, 1 - 1i}), p.Add(q))
+	assert.Equal(t, NewCPoly([]complex128{-1 + 1i, 1 + 1i}), p.Sub(q))
+	assert.True(t, p.Sub(p).IsZero())
+
+	// (ix + 1)(x - i) = ix^2 + 2x - i.
+	want := NewCPoly([]complex128{1i, 2, -1i})
+	assert.Equal(t, want, p.Mul(q))
+
+	fast := p.MulFast(q)
+	assert.Equal(t, want.deg, fast.deg)
+	for i := range want.coef {
+		assert.InDelta(t, real(want.coef[i]), real(fast.coef[i]), 1e-12)
+		assert.InDelta(t, imag(want.coef[i]), imag(fast.coef[i]), 1e-12)
+	}
+
+	assert.Equal(t, p.Mul(p).Mul(p), p.Pow(3))
+	assert.Panics(t, func() { p.Pow(-1) })
+}
+
+func Test_CPolyDiv(t *testing.T) {
+
+	assert.Panics(t, func() { NewCPolyConst(1).Div(NewCPolyZero()) })
+
+	// (x^2 + 1) / (x - i) = x + i.
+	m, n := NewCPoly([]complex128{1, 0, 1}).Div(NewCPoly([]complex128{1, -1i}))
+	assert.Equal(t, NewCPoly([]complex128{1, 1i}), m)
+	assert.True(t, n.IsZero())
+
+	// Dividing by a constant.
+	m, n = NewCPoly([]complex128{2i, 4}).Div(NewCPolyConst(2))
+	assert.Equal(t, NewCPoly([]complex128{1i, 2}), m)
+	assert.True(t, n.IsZero())
+
+	// Dividing by larger degree.
+	m, n = NewCPoly([]complex128{1i}).Div(NewCPoly([]complex128{1, 0}))
+	assert.True(t, m.IsZero())
+	assert.Equal(t, NewCPoly([]complex128{1i}), n)
+}
+
+func Test_CPolyDerivative(t *testing.T) {
+
+	assert.True(t, NewCPolyConst(1i).Derivative().IsZero())
+	assert.Equal(t, NewCPoly([]complex128{3i, 2, 0}), NewCPoly([]complex128{1i, 1, 0, 5}).Derivative())
+}
+
+func Test_CPolyString(t *testing.T) {
+
+	assert.Equal(t, "[ (0.000000+1.000000i)x^{1} + (2.000000-1.000000i)x^{0} ]",
+		NewCPoly([]complex128{1i, 2 - 1i}).String())
+}
@@ -0,0 +1,241 @@
+package polygo
+
+import (
+	"math/big"
+)
+
+// vcaMaxDepth bounds the number of bisections in isolate_vca(), which is only reached when
+// rounding errors hide the separation of two roots.
+const vcaMaxDepth = 64
+
+// squareFreePart returns the coefficients, in increasing degree, of an integer polynomial with the
+// same distinct roots as p, each of multiplicity one.
+//
+// p is divided by the greatest common divisor of p and p', computed exactly (see RatPoly.GCD()),
+// and the result is scaled to clear denominators (see intPolyFromRatPoly()).
+func squareFreePart(p Poly) []*big.Int {
+
+	rp := NewRatPolyFromPoly(p)
+
+	if p.deg > 0 {
+		rp, _ = rp.Div(rp.GCD(rp.Derivative()))
+	}
+
+	return intPolyFromRatPoly(rp)
+}
+
+// descartes_01 returns the number of sign variations of (x + 1)^n Q(1 / (x + 1)), where n =
+// deg(Q), which by Descartes' rule of signs bounds the number of roots of Q on (0, 1), and equals
+// it when it is 0 or 1.
+func descartes_01(Q []*big.Int) int {
+
+	return signVariationsInt(shiftInt(reverseInt(Q), big.NewInt(1)))
+}
+
+// halve returns 2^n Q(x / 2), where n = deg(Q), whose roots are twice those of Q.
+func halve(Q []*big.Int) []*big.Int {
+
+	n := len(Q) - 1
+	coef := make([]*big.Int, len(Q))
+	for k, c := range Q {
+		coef[k] = new(big.Int).Lsh(c, uint(n-k))
+	}
+
+	return coef
+}
+
+// isolate_vca returns the isolating intervals of the roots on (l, r) of the square-free P, where
+// Q(x) is a nonzero multiple of P(l + (r - l)x), so that the roots of Q on (0, 1) correspond to
+// those of P on (l, r).
+//
+// If rootAtR is true, r is itself a root of P, and every returned interval excludes r.
+func isolate_vca(Q []*big.Int, l, r float64, rootAtR bool, depth int) []HalfOpenInterval {
+
+	v := descartes_01(Q)
+
+	switch {
+	case v == 0:
+		return []HalfOpenInterval{}
+
+	case v == 1 && !rootAtR, depth == vcaMaxDepth:
+		return []HalfOpenInterval{{l, r}}
+	}
+
+	m := 0.5 * (l + r)
+
+	// The halves (0, 1/2) and (1/2, 1), each mapped back onto (0, 1).
+	left := halve(Q)
+	right := shiftInt(left, big.NewInt(1))
+
+	rootAtM := right[0].Sign() == 0
+
+	isolated := isolate_vca(left, l, m, rootAtM, depth+1)
+
+	if rootAtM {
+		lm := l
+		if len(isolated) > 0 {
+			lm = isolated[len(isolated)-1].R
+		}
+		isolated = append(isolated, HalfOpenInterval{lm, m})
+	}
+
+	return append(isolated, isolate_vca(right, m, r, rootAtR, depth+1)...)
+}
+
+// mapInt returns the coefficients of an integer multiple of P(a + (b - a)x), where P has integer
+// coefficients in increasing degree.
+func mapInt(P []*big.Int, a, b float64) []*big.Int {
+
+	ra := new(big.Rat).SetFloat64(a)
+	w := new(big.Rat).Sub(new(big.Rat).SetFloat64(b), ra)
+
+	// x -> (wx + a) / 1, scaled to integers.
+	m := mobius{
+		a: new(big.Int).Mul(w.Num(), ra.Denom()),
+		b: new(big.Int).Mul(ra.Num(), w.Denom()),
+		c: big.NewInt(0),
+		d: new(big.Int).Mul(w.Denom(), ra.Denom()),
+	}
+
+	return mobiusInt(P, m)
+}
+
+// vca_map returns the coefficients of an integer multiple of P(a + (b - a)x), where P is the
+// square-free part of p (see squareFreePart()), and whether b is a root of p.
+func vca_map(p Poly, a, b float64) ([]*big.Int, bool) {
+
+	Q := mapInt(squareFreePart(p), a, b)
+
+	sum := new(big.Int)
+	for _, c := range Q {
+		sum.Add(sum, c)
+	}
+
+	return Q, sum.Sign() == 0
+}
+
+// vca_isolate returns a sequence of non-overlapping half-open intervals (L, R], ordered from left
+// to right, each containing exactly one distinct root of p on (a, b].
+//
+// The Vincent-Collins-Akritas algorithm is used: the square-free part of p is mapped onto (0, 1)
+// (see vca_map()) and bisected until Descartes' rule of signs reports at most one root on each
+// piece (see descartes_01()). Every step is a Taylor shift by 1, a reciprocal or a scaling by a
+// power of 2, carried out on integers of arbitrary size, so the result is exact for every p, since
+// each float64 coefficient is a rational number.
+func vca_isolate(p Poly, a, b float64) []HalfOpenInterval {
+
+	if !(a < b) || p.deg == 0 {
+		return []HalfOpenInterval{}
+	}
+
+	Q, rootAtB := vca_map(p, a, b)
+
+	isolated := isolate_vca(Q, a, b, rootAtB, 0)
+
+	if rootAtB {
+		lb := a
+		if len(isolated) > 0 {
+			lb = isolated[len(isolated)-1].R
+		}
+		isolated = append(isolated, HalfOpenInterval{lb, b})
+	}
+
+	return isolated
+}
+
+// vca_count returns the number of distinct roots of p on (a, b].
+//
+// The Descartes bound of the square-free part of p on (a, b) is exact when it is 0 or 1 (see
+// descartes_01()). Otherwise, the roots are isolated with vca_isolate() and counted.
+func vca_count(p Poly, a, b float64) int {
+
+	if !(a < b) || p.deg == 0 {
+		return 0
+	}
+
+	Q, rootAtB := vca_map(p, a, b)
+
+	count := descartes_01(Q)
+	if count > 1 {
+		count = len(isolate_vca(Q, a, b, rootAtB, 0))
+	}
+
+	if rootAtB {
+		count++
+	}
+
+	return count
+}
+
+// SignVariations returns the number of sign changes in the sequence s, ignoring zeroes.
+//
+// # Examples:
+//   - SignVariations([]float64{1, -2, 0, 3}) = 2.
+//   - SignVariations([]float64{1, 0, 0, 4}) = 0.
+func SignVariations(s []float64) int {
+
+	return signVariations(s)
+}
+
+// DescartesBound returns upper bounds on the numbers of positive and negative roots of p, counted
+// with multiplicity, by Descartes' rule of signs.
+//
+// The bounds are the numbers of sign changes in the coefficients of p(x) and p(-x). Each exceeds
+// the true number of roots by an even number, so a bound of 0 or 1 is exact. Roots at 0 are not
+// counted.
+func (p Poly) DescartesBound() (int, int) {
+
+	neg := make([]float64, p.len)
+	for i, c := range p.coef {
+		if i%2 == 1 {
+			c = -c
+		}
+		neg[i] = c
+	}
+
+	return signVariations(p.coef), signVariations(neg)
+}
+
+// derivatives returns p and its successive derivatives, down to the constant nth derivative of p,
+// where n = deg(p).
+func derivatives(p Poly) []Poly {
+
+	derivs := []Poly{p}
+	for d := p; d.deg > 0; {
+		d = d.Derivative()
+		derivs = append(derivs, d)
+	}
+
+	return derivs
+}
+
+// budan_fourier returns V(a) - V(b), where V(x) is the number of sign changes in the values at x
+// of the given derivatives, or 0 if b <= a.
+func budan_fourier(derivs []Poly, a, b float64) int {
+
+	if b <= a {
+		return 0
+	}
+
+	va := make([]float64, len(derivs))
+	vb := make([]float64, len(derivs))
+
+	for i, d := range derivs {
+		va[i], vb[i] = d.At(a), d.At(b)
+	}
+
+	return signVariations(va) - signVariations(vb)
+}
+
+// CountBudanFourier returns an upper bound on the number of roots of p on the half-open interval
+// (a, b], counted with multiplicity.
+//
+// By the Budan-Fourier theorem, the bound V(a) - V(b), where V(x) is the number of sign changes in
+// p(x), p'(x), ..., p^(n)(x), exceeds the number of roots by an even number. So, a bound of 0 or 1
+// is exact. Unlike CountSturm(), no Sturm chain is built, which makes it a cheap test for pruning
+// intervals. The values are computed in floating point, so may have the wrong sign very close to a
+// root.
+func (p Poly) CountBudanFourier(a, b float64) int {
+
+	return budan_fourier(derivatives(p), a, b)
+}
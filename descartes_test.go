@@ -0,0 +1,240 @@
+package polygo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in descartes.go.
+*/
+
+func Test_squareFreePart(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    Poly
+		want []string
+	}{
+		{name: "constant", p: NewPolyConst(3), want: []string{"3"}},
+		{name: "square-free", p: NewPolyFactored(2, []float64{1, 2}), want: []string{"4", "-6", "2"}},
+		{name: "double root", p: NewPolyFactored(1, []float64{1, 1, 2}), want: []string{"2", "-3", "1"}},
+		{name: "rational gcd", p: NewPolyFactored(4, []float64{0.5, 0.5, -1}), want: []string{"-2", "2", "4"}},
+		{name: "power", p: NewPolyFactored(1, []float64{3, 3, 3, 3}), want: []string{"-3", "1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, intPolyString(squareFreePart(tc.p)))
+		})
+	}
+}
+
+func Test_descartes_01(t *testing.T) {
+
+	assert.Equal(t, 0, descartes_01(intPoly(-2, -1, 1)))
+	assert.Equal(t, 1, descartes_01(intPoly(2, -3, -3, 2)))
+	assert.Equal(t, 2, descartes_01(intPoly(3, -16, 16)))
+
+	// Roots at the end points are not counted.
+	assert.Equal(t, 0, descartes_01(intPoly(0, -1, 1)))
+
+	// Complex roots near (0, 1) may be counted.
+	assert.Equal(t, 2, descartes_01(intPoly(26, -100, 100)))
+}
+
+func Test_halve(t *testing.T) {
+
+	assert.Equal(t, []string{"8", "-6", "1"}, intPolyString(halve(intPoly(2, -3, 1))))
+	assert.Equal(t, []string{"5"}, intPolyString(halve(intPoly(5))))
+}
+
+func Test_vca_map(t *testing.T) {
+
+	// (x - 1)(x - 2) on (0, 4] maps to a multiple of (4x - 1)(4x - 2), with no root at 4.
+	Q, rootAtB := vca_map(NewPolyFactored(1, []float64{1, 2}), 0, 4)
+	assert.Equal(t, []string{"2", "-12", "16"}, intPolyString(Q))
+	assert.False(t, rootAtB)
+
+	_, rootAtB = vca_map(NewPolyFactored(1, []float64{1, 2}), 0, 2)
+	assert.True(t, rootAtB)
+}
+
+func Test_SolverIsolateVCA(t *testing.T) {
+	testCases := []struct {
+		name  string
+		p     Poly
+		a, b  float64
+		roots []float64
+	}{
+		{name: "no roots", p: NewPolyQuadratic(1, 0, 1), a: -2, b: 2, roots: []float64{}},
+		{name: "empty interval", p: NewPolyLinear(1, 0), a: 1, b: 1, roots: []float64{}},
+		{name: "Wilkinson", p: NewPolyWilkinson(), a: 0, b: 21,
+			roots: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}},
+		{name: "left end excluded", p: NewPolyFactored(1, []float64{-1, 0, 3}), a: -1, b: 4,
+			roots: []float64{0, 3}},
+		{name: "right end included", p: NewPolyFactored(1, []float64{-1, 0, 3}), a: -2, b: 3,
+			roots: []float64{-1, 0, 3}},
+		{name: "roots at midpoints", p: NewPolyFactored(1, []float64{1, 2, 3, 3.5}), a: 0, b: 4,
+			roots: []float64{1, 2, 3, 3.5}},
+		{name: "multiple roots", p: NewPolyFactored(1, []float64{-2, -2, -2, 1, 1, 5}), a: -10, b: 10,
+			roots: []float64{-2, 1, 5}},
+		{name: "close roots", p: NewPolyFactored(1, []float64{0.5, 0.5001, 0.5002}), a: 0, b: 1,
+			roots: []float64{0.5, 0.5001, 0.5002}},
+	}
+
+	s := NewSolver(ALG_COUNT_DESCARTES, ALG_ISOLATE_VCA, ALG_SEARCH_BISECT)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := s.IsolateRootsWithin(tc.p, tc.a, tc.b)
+
+			assert.Len(t, got, len(tc.roots))
+			assert.Equal(t, len(tc.roots), s.CountRootsWithin(tc.p, tc.a, tc.b))
+
+			for i, h := range got {
+				assert.True(t, h.L < h.R)
+				if i > 0 {
+					assert.LessOrEqual(t, got[i-1].R, h.L)
+				}
+				if i < len(tc.roots) {
+					assert.True(t, h.L < tc.roots[i] && tc.roots[i] <= h.R, "%v %v", h, tc.roots[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_SolverCountDescartes(t *testing.T) {
+
+	p := NewPolyFactored(1, []float64{-3, -1, 0, 2, 2, 4})
+
+	desc := NewSolver(ALG_COUNT_DESCARTES, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+	exact := NewSolver(ALG_COUNT_STURM_EXACT, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+
+	testCases := []struct {
+		h    HalfOpenInterval
+		want int
+	}{
+		{HalfOpenInterval{-5, 5}, 5},
+		{HalfOpenInterval{-3, 0}, 2},
+		{HalfOpenInterval{-1, 2}, 2},
+		{HalfOpenInterval{0.5, 1}, 0},
+		{HalfOpenInterval{2, 4}, 1},
+		{HalfOpenInterval{-0.5, 3.5}, 2},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, desc.CountRootsWithin(p, tc.h.L, tc.h.R), "%v", tc.h)
+		assert.Equal(t, tc.want, exact.CountRootsWithin(p, tc.h.L, tc.h.R), "%v", tc.h)
+	}
+
+	// The Descartes counter drives the bisection isolator and search.
+	assert.InDeltaSlice(t, []float64{-3, -1, 0, 2, 4}, desc.FindRootsWithin(p, -5, 5), 1e-5)
+
+	vca := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_VCA, ALG_SEARCH_NEWTON)
+	assert.InDeltaSlice(t, []float64{-3, -1, 0, 2, 4}, vca.FindRootsWithin(p, -5, 5), 1e-5)
+
+	assert.Equal(t, "ALG_COUNT_DESCARTES", ALG_COUNT_DESCARTES.String())
+	assert.Equal(t, "ALG_ISOLATE_VCA", ALG_ISOLATE_VCA.String())
+}
+
+func Test_SolverVCAWide(t *testing.T) {
+
+	// Mapping onto a wide interval gives coefficients far above 2^53, which must stay exact.
+	p := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8})
+	want := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	s := NewSolver(ALG_COUNT_DESCARTES, ALG_ISOLATE_VCA, ALG_SEARCH_BISECT)
+
+	assert.Equal(t, 8, s.CountRootsWithin(p, -1000, 1000))
+	assert.Len(t, s.IsolateRootsWithin(p, -1000, 1000), 8)
+	assert.InDeltaSlice(t, want, s.FindRoots(p), 1e-5)
+
+	vca := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_VCA, ALG_SEARCH_NEWTON)
+	assert.InDeltaSlice(t, want, vca.FindRoots(p), 1e-5)
+}
+
+func Test_SignVariations(t *testing.T) {
+
+	assert.Equal(t, 2, SignVariations([]float64{1, -2, 0, 3}))
+	assert.Equal(t, 0, SignVariations([]float64{1, 0, 0, 4}))
+	assert.Equal(t, 0, SignVariations([]float64{}))
+}
+
+func Test_PolyDescartesBound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		p        Poly
+		pos, neg int
+	}{
+		{name: "constant", p: NewPolyConst(-3), pos: 0, neg: 0},
+		{name: "double positive root", p: NewPolyFactored(1, []float64{1, 1, -1}), pos: 2, neg: 1},
+		{name: "root at zero", p: NewPolyFactored(1, []float64{0, 2, -3}), pos: 1, neg: 1},
+		{name: "complex roots", p: NewPolyQuadratic(1, -1, 1), pos: 2, neg: 0},
+		{name: "Wilkinson", p: NewPolyWilkinson(), pos: 20, neg: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pos, neg := tc.p.DescartesBound()
+
+			assert.Equal(t, tc.pos, pos)
+			assert.Equal(t, tc.neg, neg)
+		})
+	}
+}
+
+func Test_PolyCountBudanFourier(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    Poly
+		a, b float64
+		want int
+	}{
+		{name: "all roots", p: NewPolyFactored(1, []float64{1, 2, 3}), a: 0, b: 4, want: 3},
+		{name: "one root", p: NewPolyFactored(1, []float64{1, 2, 3}), a: 1.5, b: 2.5, want: 1},
+		{name: "right end included", p: NewPolyFactored(1, []float64{1, 2, 3}), a: 2.5, b: 3, want: 1},
+		{name: "left end excluded", p: NewPolyFactored(1, []float64{1, 2, 3}), a: 1, b: 1.5, want: 0},
+		{name: "multiplicity", p: NewPolyFactored(1, []float64{2, 2, 5}), a: 0, b: 3, want: 2},
+		{name: "complex pair overcounted", p: NewPolyQuadratic(1, 0, 1), a: -1, b: 1, want: 2},
+		{name: "complex pair pruned", p: NewPolyQuadratic(1, 0, 1), a: 0.5, b: 1, want: 0},
+		{name: "reversed interval", p: NewPolyLinear(1, 0), a: 1, b: -1, want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.p.CountBudanFourier(tc.a, tc.b))
+		})
+	}
+}
+
+func Test_SolverCountBudanFourier(t *testing.T) {
+
+	// Real roots at -1, 2 and 3, and a complex pair near the real axis at 0.5 +- 0.1i.
+	p := NewPolyFactored(1, []float64{-1, 2, 3}).Mul(NewPolyQuadratic(1, -1, 0.26))
+
+	s := NewSolver(ALG_COUNT_BUDAN_FOURIER, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+
+	// Decided by the bound alone.
+	assert.Equal(t, 0, p.CountBudanFourier(3.5, 5))
+	assert.Equal(t, 0, s.CountRootsWithin(p, 3.5, 5))
+	assert.Equal(t, 1, p.CountBudanFourier(2.5, 3.5))
+	assert.Equal(t, 1, s.CountRootsWithin(p, 2.5, 3.5))
+
+	// A bound of 2 from the complex pair is resolved by the Sturm count.
+	assert.Equal(t, 2, p.CountBudanFourier(0.25, 0.75))
+	assert.Equal(t, 0, s.CountRootsWithin(p, 0.25, 0.75))
+
+	assert.InDeltaSlice(t, []float64{-1, 2, 3}, s.FindRootsWithin(p, -2, 4), 1e-5)
+	assert.Equal(t, "ALG_COUNT_BUDAN_FOURIER", ALG_COUNT_BUDAN_FOURIER.String())
+}
+
+func Test_SolverCountBudanFourierCache(t *testing.T) {
+
+	// x - 1e-7 and x print alike to 6 decimal places, so must not share cached derivatives.
+	s := NewSolver(ALG_COUNT_BUDAN_FOURIER, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+
+	assert.Equal(t, 1, s.CountRootsWithin(NewPoly([]float64{1, -1e-7}), 0, 1))
+	assert.Equal(t, 0, s.CountRootsWithin(NewPoly([]float64{1, 0}), 0, 1))
+}
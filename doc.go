@@ -0,0 +1,13 @@
+/*
+Package polygo provides univariate polynomials, with root finding, approximation and
+interpolation built on them.
+
+GenericPoly is parameterized over a Ring or Field constraint and runs on any coefficient type,
+including integers mod p (see NewModInt). Poly (float64), CPoly (complex128) and RatPoly (big.Rat)
+are its GenericPoly[Real], GenericPoly[Complex] and GenericPoly[Rational] instantiations: their
+evaluation, arithmetic, division, derivatives and string forms are those of GenericPoly, and each
+converts to and from it with Generic() and NewPolyFromGeneric() (or NewCPolyFromGeneric() and
+NewRatPolyFromGeneric()). BigPoly (big.Float) carries a precision, which the Ring constraint does
+not, and keeps its own arithmetic.
+*/
+package polygo
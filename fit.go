@@ -0,0 +1,187 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+// FitOptions holds the optional settings for FitPoly(). The zero value gives an unweighted,
+// unregularized least-squares fit.
+type FitOptions struct {
+	// Weights holds a nonnegative weight for each point. If nil, every point has weight 1.
+	Weights []float64
+
+	// Ridge is the nonnegative Tikhonov (ridge) regularization parameter. The fit minimizes the
+	// weighted sum of squared residuals plus Ridge times the sum of squared coefficients.
+	Ridge float64
+}
+
+// FitStats holds residual statistics for the polynomial returned by FitPoly().
+type FitStats struct {
+	// RMS is the (weighted) root mean square of the residuals.
+	RMS float64
+
+	// RSquared is the (weighted) coefficient of determination. It is 1 for a perfect fit.
+	RSquared float64
+
+	// Condition is an estimate of the condition number of the (column-scaled) least-squares
+	// system, given by the ratio of the largest to the smallest diagonal entry of R in absolute
+	// value. Large values indicate that the coefficients are sensitive to noise in the data.
+	Condition float64
+}
+
+// FitPoly returns the polynomial p of degree at most n minimizing the sum of
+//
+// w[i](p(x[i]) - y[i])^2
+//
+// over the given points, together with statistics on the residuals of the fit.
+//
+// The least-squares problem is solved with a Householder QR factorization of the (weighted,
+// column-scaled) Vandermonde matrix rather than through the normal equations, whose condition
+// number is the square of that of the matrix.
+//
+// Panics for empty points, negative n, a weights slice of the wrong length, negative or all-zero
+// weights, or a negative ridge parameter. Without regularization, also panics for fewer than
+// n + 1 distinct abscissas among the points of positive weight, or if the system is numerically
+// rank deficient (see solveLeastSquares()), e.g. for abscissas too close together to tell apart.
+func FitPoly(points []Point, n int, opts FitOptions) (Poly, FitStats) {
+
+	if len(points) == 0 {
+		log.Panic("FitPoly: empty points.")
+	}
+
+	if n < 0 {
+		log.Panic("FitPoly: negative degree.")
+	}
+
+	if opts.Ridge < 0 {
+		log.Panic("FitPoly: negative ridge parameter.")
+	}
+
+	m := len(points)
+
+	w := opts.Weights
+	if w == nil {
+		w = make([]float64, m)
+		for i := range w {
+			w[i] = 1
+		}
+	}
+
+	if len(w) != m {
+		log.Panicf("FitPoly: %d weights for %d points.", len(w), m)
+	}
+
+	sumW := 0.0
+	for _, v := range w {
+		if v < 0 {
+			log.Panic("FitPoly: negative weight.")
+		}
+		sumW += v
+	}
+
+	if sumW == 0 {
+		log.Panic("FitPoly: all weights are zero.")
+	}
+
+	// Repeated abscissas and points of zero weight add no information.
+	distinct := map[float64]bool{}
+	for i, pt := range points {
+		if w[i] > 0 {
+			distinct[pt.X] = true
+		}
+	}
+
+	if len(distinct) < n+1 && opts.Ridge == 0 {
+		log.Panicf("FitPoly: %d distinct abscissas cannot determine a polynomial of degree %d.",
+			len(distinct), n)
+	}
+
+	// Rows of the weighted Vandermonde system sqrt(w[i])(1, x[i], ..., x[i]^n) = sqrt(w[i])y[i],
+	// followed by n + 1 ridge rows sqrt(Ridge)e_j = 0.
+	rows := m
+	if opts.Ridge > 0 {
+		rows += n + 1
+	}
+
+	a := newMatrix(rows, n+1)
+	b := make([]float64, rows)
+
+	for i, pt := range points {
+		sw := math.Sqrt(w[i])
+		pow := sw
+		for j := 0; j <= n; j++ {
+			a[i][j] = pow
+			pow *= pt.X
+		}
+		b[i] = sw * pt.Y
+	}
+
+	if opts.Ridge > 0 {
+		sr := math.Sqrt(opts.Ridge)
+		for j := 0; j <= n; j++ {
+			a[m+j][j] = sr
+		}
+	}
+
+	// Scale each column to unit norm. This does not change the solution, but it removes the
+	// artificial ill-conditioning caused by the differing magnitudes of the powers of x.
+	scale := make([]float64, n+1)
+	for j := 0; j <= n; j++ {
+		norm := 0.0
+		for i := 0; i < rows; i++ {
+			norm = math.Hypot(norm, a[i][j])
+		}
+
+		if norm == 0 {
+			norm = 1
+		}
+
+		scale[j] = norm
+		for i := 0; i < rows; i++ {
+			a[i][j] /= norm
+		}
+	}
+
+	coef, rdiag := solveLeastSquares(a, b)
+
+	for j := range coef {
+		coef[j] /= scale[j]
+	}
+
+	p := newPolyNoReverse(coef)
+
+	// Residual statistics.
+	meanY := 0.0
+	for i, pt := range points {
+		meanY += w[i] * pt.Y
+	}
+	meanY /= sumW
+
+	ssRes, ssTot := 0.0, 0.0
+	for i, pt := range points {
+		r := pt.Y - p.At(pt.X)
+		d := pt.Y - meanY
+		ssRes += w[i] * r * r
+		ssTot += w[i] * d * d
+	}
+
+	stats := FitStats{
+		RMS:      math.Sqrt(ssRes / sumW),
+		RSquared: 1,
+	}
+
+	if ssTot > 0 {
+		stats.RSquared = 1 - ssRes/ssTot
+	}
+
+	rmax, rmin := 0.0, math.Inf(1)
+	for _, r := range rdiag {
+		rmax = math.Max(rmax, math.Abs(r))
+		rmin = math.Min(rmin, math.Abs(r))
+	}
+
+	stats.Condition = rmax / rmin
+
+	return p, stats
+}
@@ -0,0 +1,100 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in fit.go.
+*/
+
+func Test_FitPolyPanic(t *testing.T) {
+
+	pts := []Point{{0, 1}, {1, 2}, {2, 5}}
+
+	assert.Panics(t, func() { FitPoly([]Point{}, 1, FitOptions{}) })
+	assert.Panics(t, func() { FitPoly(pts, -1, FitOptions{}) })
+	assert.Panics(t, func() { FitPoly(pts, 3, FitOptions{}) })
+	assert.Panics(t, func() { FitPoly(pts, 1, FitOptions{Ridge: -1}) })
+	assert.Panics(t, func() { FitPoly(pts, 1, FitOptions{Weights: []float64{1, 1}}) })
+	assert.Panics(t, func() { FitPoly(pts, 1, FitOptions{Weights: []float64{1, -1, 1}}) })
+	assert.Panics(t, func() { FitPoly(pts, 1, FitOptions{Weights: []float64{0, 0, 0}}) })
+
+	// Repeated abscissas and points of zero weight do not count.
+	repeated := []Point{{0, 1}, {0, 2}, {1, 2}, {1, 3}}
+	assert.PanicsWithValue(t, "FitPoly: 2 distinct abscissas cannot determine a polynomial of degree 2.",
+		func() { FitPoly(repeated, 2, FitOptions{}) })
+	assert.Panics(t, func() { FitPoly(pts, 2, FitOptions{Weights: []float64{1, 0, 1}}) })
+	assert.NotPanics(t, func() { FitPoly(repeated, 2, FitOptions{Ridge: 1e-3}) })
+	assert.NotPanics(t, func() { FitPoly(repeated, 1, FitOptions{}) })
+
+	// Distinct, but too close together to tell apart.
+	near := []Point{{1, 1}, {1 + 1e-15, 2}, {1 + 2e-15, 3}}
+	assert.Panics(t, func() { FitPoly(near, 2, FitOptions{}) })
+}
+
+func Test_FitPoly(t *testing.T) {
+
+	// Exact data is reproduced.
+	want := NewPoly([]float64{2, -3, 1})
+	pts := []Point{}
+	for x := -2.0; x <= 2; x += 0.5 {
+		pts = append(pts, Point{x, want.At(x)})
+	}
+
+	got, stats := FitPoly(pts, 2, FitOptions{})
+
+	assert.InDeltaSlice(t, want.Coefficients(), got.Coefficients(), 1e-12)
+	assert.InDelta(t, 0, stats.RMS, 1e-12)
+	assert.InDelta(t, 1, stats.RSquared, 1e-12)
+	assert.GreaterOrEqual(t, stats.Condition, 1.0)
+
+	// Line through symmetric noise.
+	got, stats = FitPoly([]Point{{0, 1}, {1, 2}, {2, 1}, {3, 2}}, 1, FitOptions{})
+
+	assert.InDeltaSlice(t, []float64{0.2, 1.2}, got.Coefficients(), 1e-12)
+	assert.InDelta(t, math.Sqrt(0.2), stats.RMS, 1e-12)
+	assert.InDelta(t, 0.2, stats.RSquared, 1e-12)
+
+	// Constant fit is the (weighted) mean.
+	got, _ = FitPoly([]Point{{0, 1}, {1, 4}}, 0, FitOptions{})
+	assert.InDelta(t, 2.5, got.At(0), 1e-12)
+
+	got, _ = FitPoly([]Point{{0, 1}, {1, 4}}, 0, FitOptions{Weights: []float64{2, 1}})
+	assert.InDelta(t, 2, got.At(0), 1e-12)
+
+	// Zero weights discard points entirely.
+	got, _ = FitPoly([]Point{{0, 1}, {1, 3}, {2, 100}}, 1, FitOptions{Weights: []float64{1, 1, 0}})
+	assert.InDeltaSlice(t, []float64{2, 1}, got.Coefficients(), 1e-12)
+
+	// Ridge shrinks the coefficients: minimize (c - 4)^2 + c^2.
+	got, _ = FitPoly([]Point{{0, 4}}, 0, FitOptions{Ridge: 1})
+	assert.InDelta(t, 2, got.At(0), 1e-12)
+
+	// Ridge allows underdetermined fits.
+	got, _ = FitPoly([]Point{{1, 2}}, 2, FitOptions{Ridge: 1e-3})
+	assert.InDelta(t, 2, got.At(1), 1e-2)
+}
+
+func Test_FitPolyIllConditioned(t *testing.T) {
+
+	// Degree 9 on widely spaced abscissas, where the normal equations lose all precision.
+	want := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	pts := []Point{}
+	for x := 0.5; x <= 10; x += 0.25 {
+		pts = append(pts, Point{x, want.At(x)})
+	}
+
+	got, stats := FitPoly(pts, 9, FitOptions{})
+
+	for _, pt := range pts {
+		assert.InDelta(t, pt.Y, got.At(pt.X), 1e-6*math.Abs(want.At(10)))
+	}
+
+	assert.InDelta(t, 1, stats.RSquared, 1e-12)
+	assert.Greater(t, stats.Condition, 1e3)
+}
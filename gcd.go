@@ -0,0 +1,137 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+// chop returns p with all leading coefficients of absolute value at most delta removed.
+func (p Poly) chop(delta float64) Poly {
+
+	n := p.len
+	for n > 1 && math.Abs(p.coef[n-1]) <= delta {
+		n--
+	}
+
+	if n == 1 && math.Abs(p.coef[0]) <= delta {
+		return NewPolyZero()
+	}
+
+	return newPolyNoReverse(p.coef[:n])
+}
+
+// ExtendedGCD returns the monic greatest common divisor g of p and q together with the Bezout
+// cofactors s and t such that sp + tq = g.
+//
+// Since floating point division rarely leaves an exact zero remainder, a remainder is treated as
+// zero once its coefficients are at most tol times the largest coefficient (in absolute value) of
+// p and q. Leading coefficients below that threshold are dropped along the way.
+//
+// If both p and q are zero, all three returned polynomials are zero.
+//
+// Panics for negative tol.
+func (p Poly) ExtendedGCD(q Poly, tol float64) (Poly, Poly, Poly) {
+
+	if tol < 0 {
+		log.Panicf("ExtendedGCD: negative tolerance %f.", tol)
+	}
+
+	delta := tol * math.Max(maxAbs(p.coef), maxAbs(q.coef))
+
+	r0, r1 := p.chop(delta), q.chop(delta)
+	s0, s1 := NewPolyConst(1), NewPolyZero()
+	t0, t1 := NewPolyZero(), NewPolyConst(1)
+
+	for !r1.IsZero() {
+		quo, rem := r0.Div(r1)
+
+		r0, r1 = r1, rem.chop(delta)
+		s0, s1 = s1, s0.Sub(quo.Mul(s1))
+		t0, t1 = t1, t0.Sub(quo.Mul(t1))
+	}
+
+	if r0.IsZero() {
+		return NewPolyZero(), NewPolyZero(), NewPolyZero()
+	}
+
+	lead := 1 / r0.LeadingCoefficient()
+
+	return r0.MulScalar(lead), s0.MulScalar(lead), t0.MulScalar(lead)
+}
+
+// GCD returns the monic greatest common divisor of p and q.
+//
+// See ExtendedGCD() for the meaning of tol.
+//
+// Panics for negative tol.
+func (p Poly) GCD(q Poly, tol float64) Poly {
+
+	if tol < 0 {
+		log.Panicf("GCD: negative tolerance %f.", tol)
+	}
+
+	delta := tol * math.Max(maxAbs(p.coef), maxAbs(q.coef))
+
+	r0, r1 := p.chop(delta), q.chop(delta)
+
+	for !r1.IsZero() {
+		_, rem := r0.Div(r1)
+		r0, r1 = r1, rem.chop(delta)
+	}
+
+	if r0.IsZero() {
+		return r0
+	}
+
+	return r0.Monic()
+}
+
+// LCM returns the monic least common multiple of p and q.
+//
+// If either p or q is zero, the zero polynomial is returned. See ExtendedGCD() for the meaning of
+// tol.
+//
+// Panics for negative tol.
+func (p Poly) LCM(q Poly, tol float64) Poly {
+
+	if tol < 0 {
+		log.Panicf("LCM: negative tolerance %f.", tol)
+	}
+
+	if p.IsZero() || q.IsZero() {
+		return NewPolyZero()
+	}
+
+	quo, _ := p.Div(p.GCD(q, tol))
+
+	return quo.Mul(q).Monic()
+}
+
+// ExtendedGCDExact returns the monic greatest common divisor g of p and q together with the Bezout
+// cofactors s and t such that sp + tq = g.
+//
+// The computation is carried out exactly on the rational values of the coefficients (see
+// RatPoly), so no tolerance is needed. The results are only rounded when converted back to Poly,
+// which is exact whenever they have moderately sized integer (or dyadic) coefficients.
+func (p Poly) ExtendedGCDExact(q Poly) (Poly, Poly, Poly) {
+
+	g, s, t := NewRatPolyFromPoly(p).ExtendedGCD(NewRatPolyFromPoly(q))
+
+	return g.ToPoly(), s.ToPoly(), t.ToPoly()
+}
+
+// GCDExact returns the monic greatest common divisor of p and q.
+//
+// See ExtendedGCDExact() for how the computation is carried out.
+func (p Poly) GCDExact(q Poly) Poly {
+
+	return NewRatPolyFromPoly(p).GCD(NewRatPolyFromPoly(q)).ToPoly()
+}
+
+// LCMExact returns the monic least common multiple of p and q.
+//
+// See ExtendedGCDExact() for how the computation is carried out.
+func (p Poly) LCMExact(q Poly) Poly {
+
+	return NewRatPolyFromPoly(p).LCM(NewRatPolyFromPoly(q)).ToPoly()
+}
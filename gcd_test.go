@@ -0,0 +1,119 @@
+package polygo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in gcd.go.
+*/
+
+func Test_Polychop(t *testing.T) {
+
+	assert.Equal(t, NewPoly([]float64{1, 2}), NewPoly([]float64{1e-12, 1, 2}).chop(1e-9))
+	assert.Equal(t, NewPolyZero(), NewPoly([]float64{1e-12, -1e-13}).chop(1e-9))
+	assert.Equal(t, NewPoly([]float64{1e-12, 1, 2}), NewPoly([]float64{1e-12, 1, 2}).chop(0))
+}
+
+func Test_PolyGCDPanic(t *testing.T) {
+
+	p := NewPolyLinear(1, 1)
+
+	assert.Panics(t, func() { p.GCD(p, -1) })
+	assert.Panics(t, func() { p.LCM(p, -1) })
+	assert.Panics(t, func() { p.ExtendedGCD(p, -1) })
+}
+
+func Test_PolyGCD(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		argP Poly
+		argQ Poly
+		want Poly
+	}{
+		{
+			name: "common linear factor",
+			argP: NewPolyFactored(1, []float64{1, 1, -2}),
+			argQ: NewPolyFactored(5, []float64{1, -3}),
+			want: NewPolyLinear(1, -1),
+		},
+		{
+			name: "common quadratic factor",
+			argP: NewPolyFactored(2, []float64{0.5, 3, -1}),
+			argQ: NewPolyFactored(-3, []float64{3, 0.5, 7}),
+			want: NewPolyFactored(1, []float64{0.5, 3}),
+		},
+		{
+			name: "coprime",
+			argP: NewPoly([]float64{1, 0, 1}),
+			argQ: NewPolyLinear(1, -1),
+			want: NewPolyConst(1),
+		},
+		{
+			name: "zero and nonzero",
+			argP: NewPolyZero(),
+			argQ: NewPolyLinear(2, -1),
+			want: NewPolyLinear(1, -0.5),
+		},
+		{
+			name: "both zero",
+			argP: NewPolyZero(),
+			argQ: NewPolyZero(),
+			want: NewPolyZero(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.argP.GCD(tc.argQ, 1e-9)
+			assert.True(t, tc.want.EqualRel(got, 1e-9), "got %v", got)
+
+			exact := tc.argP.GCDExact(tc.argQ)
+			assert.True(t, tc.want.EqualRel(exact, 1e-12), "got %v", exact)
+		})
+	}
+}
+
+func Test_PolyGCDTolerance(t *testing.T) {
+
+	// A tiny perturbation destroys the common root unless the tolerance allows for it.
+	p := NewPolyFactored(1, []float64{1, 2})
+	q := NewPolyFactored(1, []float64{1 + 1e-10, 3})
+
+	assert.Equal(t, 0, p.GCD(q, 0).Degree())
+	assert.Equal(t, 1, p.GCD(q, 1e-8).Degree())
+}
+
+func Test_PolyExtendedGCD(t *testing.T) {
+
+	p := NewPolyFactored(1, []float64{1, 1, -2})
+	q := NewPolyFactored(1, []float64{1, -3, 4})
+
+	check := func(g, s, u Poly) {
+		assert.True(t, NewPolyLinear(1, -1).EqualRel(g, 1e-9))
+
+		bezout := s.Mul(p).Add(u.Mul(q))
+		assert.True(t, g.Sub(bezout).chop(1e-9).IsZero(), "sp + tq = %v", bezout)
+	}
+
+	check(p.ExtendedGCD(q, 1e-9))
+	check(p.ExtendedGCDExact(q))
+
+	g, s, u := NewPolyZero().ExtendedGCD(NewPolyZero(), 0)
+	assert.True(t, g.IsZero() && s.IsZero() && u.IsZero())
+}
+
+func Test_PolyLCM(t *testing.T) {
+
+	p := NewPolyFactored(2, []float64{1, 2})
+	q := NewPolyFactored(3, []float64{2, 3})
+	want := NewPolyFactored(1, []float64{1, 2, 3})
+
+	assert.True(t, want.EqualRel(p.LCM(q, 1e-9), 1e-9))
+	assert.True(t, want.Equal(p.LCMExact(q)))
+	assert.True(t, p.LCM(NewPolyZero(), 0).IsZero())
+	assert.True(t, p.LCMExact(NewPolyZero()).IsZero())
+}
@@ -0,0 +1,564 @@
+package polygo
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"math/bits"
+	"strings"
+	"unsafe"
+)
+
+// Ring is the constraint satisfied by coefficient types of a GenericPoly.
+//
+// Zero and One return the additive and multiplicative identities belonging to the same ring as the
+// receiver. This lets element types carry context (such as a modulus) without a separate
+// descriptor being threaded through every operation.
+type Ring[T any] interface {
+	Add(T) T
+	Sub(T) T
+	Mul(T) T
+	Neg() T
+	Zero() T
+	One() T
+	IsZero() bool
+	Equal(T) bool
+	String() string
+}
+
+// Field is the constraint satisfied by coefficient types that also support division by nonzero
+// elements.
+type Field[T any] interface {
+	Ring[T]
+	Quo(T) T
+}
+
+// A GenericPoly represents a univariate polynomial with coefficients in the ring T.
+//
+// Poly, CPoly and RatPoly are the GenericPoly[Real], GenericPoly[Complex] and GenericPoly[Rational]
+// instantiations: their At, Add, Sub, MulScalar, Mul, Pow, Div, Derivative and String methods run
+// the methods below on the same coefficients (see Poly.Generic()).
+//
+// Note: in the documentation for each method of GenericPoly, we refer to the receiver instance as
+// "p".
+type GenericPoly[T Ring[T]] struct {
+	coef []T
+	len  int
+	deg  int
+}
+
+// NewGenericPoly returns a polynomial p with the given coefficients.
+//
+// Let c = coefficients and let n = len(c). Then, p is defined by
+//
+//   - p(x) = c[0]x^(n-1) + c[1]x^(n-2) + ... + c[n-2]x^1 + c[n-1]x^0.
+//
+// Panics if coefficients slice is empty.
+func NewGenericPoly[T Ring[T]](coefficients []T) GenericPoly[T] {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewGenericPoly: empty coefficients slice.")
+	}
+
+	n := len(coefficients)
+	coef := make([]T, n)
+
+	for i, c := range coefficients {
+		coef[n-i-1] = c
+	}
+
+	return newGenericPolyNoReverse(coef)
+}
+
+// newGenericPolyNoReverse is just NewGenericPoly but with no coefficient slice reversal.
+func newGenericPolyNoReverse[T Ring[T]](coefficients []T) GenericPoly[T] {
+
+	for len(coefficients) > 1 && coefficients[len(coefficients)-1].IsZero() {
+		coefficients = coefficients[:len(coefficients)-1]
+	}
+
+	coefLen := len(coefficients)
+
+	return GenericPoly[T]{
+		coef: coefficients,
+		len:  coefLen,
+		deg:  coefLen - 1,
+	}
+}
+
+// intMultiplier is implemented by coefficient types that can multiply by an integer directly.
+type intMultiplier[T any] interface {
+	MulInt(int) T
+}
+
+// mulInt returns c added to itself n times.
+//
+// If T implements intMultiplier, its MulInt is used, which rounds once for Real and Complex.
+// Otherwise, double-and-add is used.
+func mulInt[T Ring[T]](c T, n int) T {
+
+	if m, ok := any(c).(intMultiplier[T]); ok {
+		return m.MulInt(n)
+	}
+
+	sum := c.Zero()
+
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			sum = sum.Add(c)
+		}
+		c = c.Add(c)
+	}
+
+	return sum
+}
+
+// Coefficients returns the coefficients c of p ordered in decreasing degree.
+func (p GenericPoly[T]) Coefficients() []T {
+
+	ret := make([]T, p.len)
+	for i, c := range p.coef {
+		ret[p.len-i-1] = c
+	}
+
+	return ret
+}
+
+// Degree returns the degree of p.
+func (p GenericPoly[T]) Degree() int {
+
+	return p.deg
+}
+
+// LeadingCoefficient returns the coefficient of the highest-degreed term in p.
+func (p GenericPoly[T]) LeadingCoefficient() T {
+
+	return p.coef[p.deg]
+}
+
+// Equal returns true if the p is equal to q (all corresponding coefficients are equal), else false.
+func (p GenericPoly[T]) Equal(q GenericPoly[T]) bool {
+
+	if p.deg != q.deg {
+		return false
+	}
+
+	for i := 0; i < p.len; i++ {
+		if !p.coef[i].Equal(q.coef[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZero returns true if p(x) = 0, else false.
+func (p GenericPoly[T]) IsZero() bool {
+
+	return p.deg == 0 && p.coef[0].IsZero()
+}
+
+// At returns the value of p evaluated at x.
+func (p GenericPoly[T]) At(x T) T {
+
+	// Implement Horner's scheme.
+	out := p.coef[p.deg]
+	for i := p.deg - 1; i >= 0; i-- {
+		out = out.Mul(x).Add(p.coef[i])
+	}
+
+	return out
+}
+
+// Add returns the polynomial sum p + q.
+func (p GenericPoly[T]) Add(q GenericPoly[T]) GenericPoly[T] {
+
+	if p.len < q.len {
+		p, q = q, p
+	}
+
+	sumCoef := make([]T, p.len)
+	copy(sumCoef, p.coef)
+
+	for i := 0; i < q.len; i++ {
+		sumCoef[i] = sumCoef[i].Add(q.coef[i])
+	}
+
+	return newGenericPolyNoReverse(sumCoef)
+}
+
+// Sub returns the polynomial difference p - q.
+func (p GenericPoly[T]) Sub(q GenericPoly[T]) GenericPoly[T] {
+
+	return p.Add(q.Neg())
+}
+
+// Neg returns the polynomial -p.
+func (p GenericPoly[T]) Neg() GenericPoly[T] {
+
+	negCoef := make([]T, p.len)
+	for i, c := range p.coef {
+		negCoef[i] = c.Neg()
+	}
+
+	return newGenericPolyNoReverse(negCoef)
+}
+
+// MulScalar returns the scalar-polynomial product sp.
+func (p GenericPoly[T]) MulScalar(s T) GenericPoly[T] {
+
+	prodCoef := make([]T, p.len)
+	for i, c := range p.coef {
+		prodCoef[i] = s.Mul(c)
+	}
+
+	return newGenericPolyNoReverse(prodCoef)
+}
+
+// Mul returns the polynomial product pq.
+func (p GenericPoly[T]) Mul(q GenericPoly[T]) GenericPoly[T] {
+
+	prodCoef := make([]T, p.deg+q.deg+1)
+	for i := range prodCoef {
+		prodCoef[i] = p.coef[0].Zero()
+	}
+
+	for i := 0; i < p.len; i++ {
+		for j := 0; j < q.len; j++ {
+			prodCoef[i+j] = prodCoef[i+j].Add(p.coef[i].Mul(q.coef[j]))
+		}
+	}
+
+	return newGenericPolyNoReverse(prodCoef)
+}
+
+// Pow returns the polynomial power p^n.
+//
+// Panics for negative n.
+func (p GenericPoly[T]) Pow(n int) GenericPoly[T] {
+
+	if n < 0 {
+		log.Panic("Pow: negative n.")
+	}
+
+	prod := newGenericPolyNoReverse([]T{p.coef[0].One()})
+
+	for i := 0; i < n; i++ {
+		prod = prod.Mul(p)
+	}
+
+	return prod
+}
+
+// Derivative returns the formal derivative of p.
+func (p GenericPoly[T]) Derivative() GenericPoly[T] {
+
+	if p.deg == 0 {
+		return newGenericPolyNoReverse([]T{p.coef[0].Zero()})
+	}
+
+	derivCoef := make([]T, p.deg)
+	for i := 0; i < p.deg; i++ {
+		derivCoef[i] = mulInt(p.coef[i+1], i+1)
+	}
+
+	return newGenericPolyNoReverse(derivCoef)
+}
+
+// String returns a string representation of p in decreasing-degree sum form.
+//
+// Each coefficient after the leading one is joined with " - " if its string starts with '-', and
+// with " + " otherwise.
+func (p GenericPoly[T]) String() string {
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[ %sx^{%d}", p.coef[p.deg], p.deg))
+
+	for i := 1; i < p.len; i++ {
+		strCoef := p.coef[p.deg-i].String()
+
+		if strings.HasPrefix(strCoef, "-") {
+			sb.WriteString(" - ")
+			strCoef = strCoef[1:]
+		} else {
+			sb.WriteString(" + ")
+		}
+
+		sb.WriteString(fmt.Sprintf("%sx^{%d}", strCoef, p.deg-i))
+	}
+
+	sb.WriteString(" ]")
+
+	return sb.String()
+}
+
+// GenericDiv returns m (polynomial quotient) and n (polynomial remainder) such that p/q = m + n/q.
+//
+// Division needs a field of coefficients, so unlike the other operations it is a function rather
+// than a method of GenericPoly.
+//
+// Panics if q = 0.
+func GenericDiv[T Field[T]](p, q GenericPoly[T]) (GenericPoly[T], GenericPoly[T]) {
+
+	if q.IsZero() {
+		log.Panic("GenericDiv: division by zero polynomial.")
+	}
+
+	zero := p.coef[0].Zero()
+
+	if p.deg < q.deg {
+		return newGenericPolyNoReverse([]T{zero}), p
+	}
+
+	rem := make([]T, p.len)
+	copy(rem, p.coef)
+
+	quoCoef := make([]T, p.deg-q.deg+1)
+	lead := q.coef[q.deg]
+
+	for i := p.deg - q.deg; i >= 0; i-- {
+		c := rem[i+q.deg].Quo(lead)
+		quoCoef[i] = c
+
+		for j := 0; j < q.deg; j++ {
+			rem[i+j] = rem[i+j].Sub(c.Mul(q.coef[j]))
+		}
+	}
+
+	remCoef := rem[:q.deg]
+	if q.deg == 0 {
+		remCoef = []T{zero}
+	}
+
+	return newGenericPolyNoReverse(quoCoef), newGenericPolyNoReverse(remCoef)
+}
+
+// Real is a float64 satisfying Field.
+type Real float64
+
+func (a Real) Add(b Real) Real   { return a + b }
+func (a Real) Sub(b Real) Real   { return a - b }
+func (a Real) Mul(b Real) Real   { return a * b }
+func (a Real) Quo(b Real) Real   { return a / b }
+func (a Real) MulInt(n int) Real { return a * Real(n) }
+func (a Real) Neg() Real         { return -a }
+func (a Real) Zero() Real        { return 0 }
+func (a Real) One() Real         { return 1 }
+func (a Real) IsZero() bool      { return a == 0 }
+func (a Real) Equal(b Real) bool { return a == b }
+func (a Real) String() string    { return fmt.Sprintf("%f", float64(a)) }
+
+// Complex is a complex128 satisfying Field.
+type Complex complex128
+
+func (a Complex) Add(b Complex) Complex { return a + b }
+func (a Complex) Sub(b Complex) Complex { return a - b }
+func (a Complex) Mul(b Complex) Complex { return a * b }
+func (a Complex) Quo(b Complex) Complex { return a / b }
+func (a Complex) MulInt(n int) Complex  { return a * Complex(complex(float64(n), 0)) }
+func (a Complex) Neg() Complex          { return -a }
+func (a Complex) Zero() Complex         { return 0 }
+func (a Complex) One() Complex          { return 1 }
+func (a Complex) IsZero() bool          { return a == 0 }
+func (a Complex) Equal(b Complex) bool  { return a == b }
+func (a Complex) String() string        { return fmt.Sprintf("%f", complex128(a)) }
+
+// Rational is an exact rational number satisfying Field.
+//
+// The zero value of Rational is 0. Rationals are immutable; every operation allocates a new value.
+type Rational struct {
+	v *big.Rat
+}
+
+// NewRational returns the rational number a/b.
+//
+// Panics if b is 0.
+func NewRational(a, b int64) Rational {
+
+	if b == 0 {
+		log.Panic("NewRational: zero denominator.")
+	}
+
+	return Rational{big.NewRat(a, b)}
+}
+
+// NewRationalFromRat returns a copy of r as a Rational.
+func NewRationalFromRat(r *big.Rat) Rational {
+
+	return Rational{new(big.Rat).Set(r)}
+}
+
+// Rat returns a copy of a as a *big.Rat.
+func (a Rational) Rat() *big.Rat {
+
+	if a.v == nil {
+		return new(big.Rat)
+	}
+
+	return new(big.Rat).Set(a.v)
+}
+
+// rat returns the underlying value of a, treating nil as 0, without copying.
+func (a Rational) rat() *big.Rat {
+
+	if a.v == nil {
+		return new(big.Rat)
+	}
+
+	return a.v
+}
+
+func (a Rational) Add(b Rational) Rational { return Rational{new(big.Rat).Add(a.rat(), b.rat())} }
+func (a Rational) Sub(b Rational) Rational { return Rational{new(big.Rat).Sub(a.rat(), b.rat())} }
+func (a Rational) Mul(b Rational) Rational { return Rational{new(big.Rat).Mul(a.rat(), b.rat())} }
+func (a Rational) Quo(b Rational) Rational { return Rational{new(big.Rat).Quo(a.rat(), b.rat())} }
+func (a Rational) MulInt(n int) Rational {
+	return Rational{new(big.Rat).Mul(a.rat(), big.NewRat(int64(n), 1))}
+}
+func (a Rational) Neg() Rational         { return Rational{new(big.Rat).Neg(a.rat())} }
+func (a Rational) Zero() Rational        { return Rational{new(big.Rat)} }
+func (a Rational) One() Rational         { return Rational{big.NewRat(1, 1)} }
+func (a Rational) IsZero() bool          { return a.rat().Sign() == 0 }
+func (a Rational) Equal(b Rational) bool { return a.rat().Cmp(b.rat()) == 0 }
+func (a Rational) String() string        { return a.rat().RatString() }
+
+// ModInt is an element of the integers modulo m satisfying Field when m is prime.
+//
+// The modulus is carried by every element, so elements with different moduli must not be mixed.
+type ModInt struct {
+	v, m uint64
+}
+
+// NewModInt returns the residue of v modulo m.
+//
+// Panics if m is 0.
+func NewModInt(v int64, m uint64) ModInt {
+
+	if m == 0 {
+		log.Panic("NewModInt: zero modulus.")
+	}
+
+	if v >= 0 {
+		return ModInt{uint64(v) % m, m}
+	}
+
+	// -(v + 1) cannot overflow, unlike -v.
+	neg := (uint64(-(v+1))%m + 1) % m
+
+	return ModInt{(m - neg) % m, m}
+}
+
+// Value returns the canonical representative of a on [0, m).
+func (a ModInt) Value() uint64 {
+
+	return a.v
+}
+
+// Modulus returns the modulus of a.
+func (a ModInt) Modulus() uint64 {
+
+	return a.m
+}
+
+func (a ModInt) Add(b ModInt) ModInt {
+	s, carry := bits.Add64(a.v, b.v, 0)
+	if carry != 0 || s >= a.m {
+		s -= a.m
+	}
+	return ModInt{s, a.m}
+}
+
+func (a ModInt) Sub(b ModInt) ModInt {
+	if a.v >= b.v {
+		return ModInt{a.v - b.v, a.m}
+	}
+	return ModInt{a.m - (b.v - a.v), a.m}
+}
+
+func (a ModInt) Mul(b ModInt) ModInt {
+	hi, lo := bits.Mul64(a.v, b.v)
+	_, r := bits.Div64(hi, lo, a.m)
+	return ModInt{r, a.m}
+}
+
+// Quo returns a/b, computing the inverse of b with the extended Euclidean algorithm.
+//
+// Panics if b is not invertible modulo m.
+func (a ModInt) Quo(b ModInt) ModInt {
+
+	inv := new(big.Int).ModInverse(new(big.Int).SetUint64(b.v), new(big.Int).SetUint64(a.m))
+	if inv == nil {
+		log.Panicf("Quo: %d is not invertible modulo %d.", b.v, a.m)
+	}
+
+	return a.Mul(ModInt{inv.Uint64(), a.m})
+}
+
+func (a ModInt) Neg() ModInt         { return ModInt{0, a.m}.Sub(a) }
+func (a ModInt) Zero() ModInt        { return ModInt{0, a.m} }
+func (a ModInt) One() ModInt         { return ModInt{1 % a.m, a.m} }
+func (a ModInt) IsZero() bool        { return a.v == 0 }
+func (a ModInt) Equal(b ModInt) bool { return a.v == b.v && a.m == b.m }
+func (a ModInt) String() string      { return fmt.Sprintf("%d", a.v) }
+
+// Generic returns p as a GenericPoly[Real].
+//
+// Real has the underlying type float64, so the two polynomials share their coefficients rather
+// than copying them. Neither type ever modifies its coefficients, so this is safe.
+func (p Poly) Generic() GenericPoly[Real] {
+
+	coef := unsafe.Slice((*Real)(unsafe.SliceData(p.coef)), len(p.coef))
+
+	return GenericPoly[Real]{coef: coef, len: p.len, deg: p.deg}
+}
+
+// NewPolyFromGeneric returns the float64 instantiation p as a Poly, sharing its coefficients (see
+// Poly.Generic()).
+func NewPolyFromGeneric(p GenericPoly[Real]) Poly {
+
+	coef := unsafe.Slice((*float64)(unsafe.SliceData(p.coef)), len(p.coef))
+
+	return Poly{coef: coef, len: p.len, deg: p.deg}
+}
+
+// Generic returns p as a GenericPoly[Complex], sharing its coefficients (see Poly.Generic()).
+func (p CPoly) Generic() GenericPoly[Complex] {
+
+	coef := unsafe.Slice((*Complex)(unsafe.SliceData(p.coef)), len(p.coef))
+
+	return GenericPoly[Complex]{coef: coef, len: p.len, deg: p.deg}
+}
+
+// NewCPolyFromGeneric returns the complex128 instantiation p as a CPoly, sharing its coefficients
+// (see Poly.Generic()).
+func NewCPolyFromGeneric(p GenericPoly[Complex]) CPoly {
+
+	coef := unsafe.Slice((*complex128)(unsafe.SliceData(p.coef)), len(p.coef))
+
+	return CPoly{coef: coef, len: p.len, deg: p.deg}
+}
+
+// Generic returns p as a GenericPoly[Rational], sharing the values of its coefficients (see
+// Poly.Generic()).
+func (p RatPoly) Generic() GenericPoly[Rational] {
+
+	coef := make([]Rational, p.len)
+	for i, c := range p.coef {
+		coef[i] = Rational{c}
+	}
+
+	return GenericPoly[Rational]{coef: coef, len: p.len, deg: p.deg}
+}
+
+// NewRatPolyFromGeneric returns the rational instantiation p as a RatPoly, sharing the values of
+// its coefficients (see Poly.Generic()).
+func NewRatPolyFromGeneric(p GenericPoly[Rational]) RatPoly {
+
+	coef := make([]*big.Rat, p.len)
+	for i, c := range p.coef {
+		coef[i] = c.rat()
+	}
+
+	return RatPoly{coef: coef, len: p.len, deg: p.deg}
+}
@@ -0,0 +1,121 @@
+package polygo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in generic.go.
+*/
+
+func Test_NewGenericPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewGenericPoly([]Real{}) })
+	assert.Panics(t, func() { NewRational(1, 0) })
+	assert.Panics(t, func() { NewModInt(1, 0) })
+}
+
+func Test_GenericPolyReal(t *testing.T) {
+
+	p := NewPoly([]float64{3, -1, 4})
+	q := NewPoly([]float64{1, 2})
+	gp, gq := p.Generic(), q.Generic()
+
+	assert.Equal(t, 2, gp.Degree())
+	assert.Equal(t, []Real{3, -1, 4}, gp.Coefficients())
+	assert.Equal(t, p, NewPolyFromGeneric(gp))
+
+	assert.Equal(t, p.Add(q), NewPolyFromGeneric(gp.Add(gq)))
+	assert.Equal(t, p.Sub(q), NewPolyFromGeneric(gp.Sub(gq)))
+	assert.Equal(t, p.Mul(q), NewPolyFromGeneric(gp.Mul(gq)))
+	assert.Equal(t, p.Pow(3), NewPolyFromGeneric(gp.Pow(3)))
+	assert.Equal(t, p.Derivative(), NewPolyFromGeneric(gp.Derivative()))
+	assert.Equal(t, p.At(1.5), float64(gp.At(1.5)))
+
+	m, n := p.Div(q)
+	gm, gn := GenericDiv(gp, gq)
+	assert.Equal(t, m, NewPolyFromGeneric(gm))
+	assert.Equal(t, n, NewPolyFromGeneric(gn))
+
+	assert.Equal(t, "[ 3.000000x^{2} - 1.000000x^{1} + 4.000000x^{0} ]", gp.String())
+	assert.Equal(t, p.String(), gp.String())
+	assert.Panics(t, func() { gp.Pow(-1) })
+	assert.Panics(t, func() { GenericDiv(gp, NewGenericPoly([]Real{0})) })
+
+	// Derivatives scale by the exponent directly rather than by repeated addition.
+	c := 0.1
+	r := NewPoly([]float64{c, 0, 0, 0, 0, 0, 0, 0})
+	assert.Equal(t, c*7, NewPolyFromGeneric(r.Generic().Derivative()).LeadingCoefficient())
+}
+
+func Test_GenericPolyComplex(t *testing.T) {
+
+	p := NewCPoly([]complex128{1, 0, 1})
+	q := NewCPoly([]complex128{1, -1i})
+	gp, gq := p.Generic(), q.Generic()
+
+	assert.True(t, p.Equal(NewCPolyFromGeneric(gp)))
+	assert.True(t, p.Mul(q).Equal(NewCPolyFromGeneric(gp.Mul(gq))))
+	assert.Equal(t, Complex(0), gp.At(1i))
+
+	m, n := GenericDiv(gp, gq)
+	assert.True(t, NewCPoly([]complex128{1, 1i}).Equal(NewCPolyFromGeneric(m)))
+	assert.True(t, n.IsZero())
+}
+
+func Test_GenericPolyRational(t *testing.T) {
+
+	p := NewRatPoly(rats(2, 0, -3, 1))
+	q := NewRatPoly(rats(2, 0, 1))
+	gp, gq := p.Generic(), q.Generic()
+
+	assert.True(t, p.Equal(NewRatPolyFromGeneric(gp)))
+	assert.True(t, p.Mul(q).Equal(NewRatPolyFromGeneric(gp.Mul(gq))))
+	assert.True(t, p.Derivative().Equal(NewRatPolyFromGeneric(gp.Derivative())))
+
+	m, n := GenericDiv(gp, gq)
+	wantM, wantN := p.Div(q)
+	assert.True(t, wantM.Equal(NewRatPolyFromGeneric(m)))
+	assert.True(t, wantN.Equal(NewRatPolyFromGeneric(n)))
+
+	half := NewGenericPoly([]Rational{NewRational(1, 2), {}})
+	assert.Equal(t, "[ 1/2x^{1} + 0x^{0} ]", half.String())
+	assert.Equal(t, big.NewRat(1, 4), half.At(NewRational(1, 2)).Rat())
+}
+
+func Test_GenericPolyModInt(t *testing.T) {
+
+	gf7 := func(s ...int64) []ModInt {
+		ret := make([]ModInt, len(s))
+		for i, v := range s {
+			ret[i] = NewModInt(v, 7)
+		}
+		return ret
+	}
+
+	assert.Equal(t, uint64(5), NewModInt(-2, 7).Value())
+	assert.Equal(t, uint64(7), NewModInt(-2, 7).Modulus())
+	assert.Equal(t, uint64(1), NewModInt(-9223372036854775808, 7).Add(NewModInt(2, 7)).Value())
+
+	// x^7 - x vanishes everywhere on GF(7), and its derivative 7x^6 - 1 = -1.
+	p := NewGenericPoly(gf7(1, 0, 0, 0, 0, 0, -1, 0))
+	for v := int64(0); v < 7; v++ {
+		assert.True(t, p.At(NewModInt(v, 7)).IsZero())
+	}
+	assert.True(t, NewGenericPoly(gf7(6)).Equal(p.Derivative()))
+
+	// (x + 1)^7 = x^7 + 1 in characteristic 7.
+	assert.True(t, NewGenericPoly(gf7(1, 0, 0, 0, 0, 0, 0, 1)).Equal(NewGenericPoly(gf7(1, 1)).Pow(7)))
+
+	// (x^2 + 1) / (2x + 3) over GF(7).
+	a, b := NewGenericPoly(gf7(1, 0, 1)), NewGenericPoly(gf7(2, 3))
+	m, n := GenericDiv(a, b)
+	assert.True(t, a.Equal(m.Mul(b).Add(n)))
+	assert.Equal(t, 0, n.Degree())
+
+	assert.Equal(t, uint64(4), NewModInt(1, 7).Quo(NewModInt(2, 7)).Value())
+	assert.Panics(t, func() { NewModInt(1, 8).Quo(NewModInt(2, 8)) })
+}
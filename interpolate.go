@@ -0,0 +1,291 @@
+package polygo
+
+import "log"
+
+// checkDistinctNodes panics if any two of the given abscissas are equal.
+func checkDistinctNodes(caller string, x []float64) {
+
+	seen := make(map[float64]bool, len(x))
+
+	for _, v := range x {
+		if seen[v] {
+			log.Panicf("%s: repeated abscissa %f.", caller, v)
+		}
+		seen[v] = true
+	}
+}
+
+// NewPolyInterpolate returns the unique polynomial p of degree at most n - 1 passing through the n
+// given points.
+//
+// The barycentric form of the Lagrange interpolating polynomial is expanded into the monomial
+// basis.
+//
+// Panics for empty points or repeated abscissas.
+func NewPolyInterpolate(points []Point) Poly {
+
+	if len(points) == 0 {
+		log.Panic("NewPolyInterpolate: empty points.")
+	}
+
+	n := len(points)
+	x := make([]float64, n)
+	for i, pt := range points {
+		x[i] = pt.X
+	}
+
+	checkDistinctNodes("NewPolyInterpolate", x)
+
+	if n == 1 {
+		return NewPolyConst(points[0].Y)
+	}
+
+	// l(x) = (x - x[0])(x - x[1])...(x - x[n - 1]).
+	l := NewPolyFactored(1, x)
+
+	sumCoef := make([]float64, n)
+
+	for j, pt := range points {
+
+		// Barycentric weight w[j] = 1 / prod_{k != j} (x[j] - x[k]).
+		w := 1.0
+		for k := 0; k < n; k++ {
+			if k != j {
+				w *= x[j] - x[k]
+			}
+		}
+		w = pt.Y / w
+
+		// l(x) / (x - x[j]) by synthetic division.
+		lj, _ := l.Div(NewPolyLinear(1, -x[j]))
+
+		for i := 0; i <= lj.deg; i++ {
+			sumCoef[i] += w * lj.coef[i]
+		}
+	}
+
+	return newPolyNoReverse(sumCoef)
+}
+
+// newtonToPoly returns the polynomial with Newton form
+//
+// c[0] + c[1](x - z[0]) + c[2](x - z[0])(x - z[1]) + ... + c[n-1](x - z[0])...(x - z[n-2])
+//
+// in the monomial basis.
+func newtonToPoly(z, c []float64) Poly {
+
+	n := len(c)
+	out := NewPolyConst(c[n-1])
+
+	for k := n - 2; k >= 0; k-- {
+		out = out.Mul(NewPolyLinear(1, -z[k])).Add(NewPolyConst(c[k]))
+	}
+
+	return out
+}
+
+// A NewtonInterpolator represents an interpolating polynomial in Newton's divided-difference form,
+// to which points may be added one at a time.
+type NewtonInterpolator struct {
+	x []float64
+
+	// Newton coefficients c[k] = f[x[0], ..., x[k]].
+	c []float64
+
+	// Last row of the divided-difference table, row[k] = f[x[m - k], ..., x[m]] for the most
+	// recently added point x[m].
+	row []float64
+}
+
+// NewNewtonInterpolator returns a NewtonInterpolator through the given points.
+//
+// Panics for repeated abscissas.
+func NewNewtonInterpolator(points []Point) *NewtonInterpolator {
+
+	ni := &NewtonInterpolator{}
+
+	for _, pt := range points {
+		ni.AddPoint(pt)
+	}
+
+	return ni
+}
+
+// AddPoint adds pt to the interpolated points, raising the degree of the interpolant by one.
+//
+// Only the new divided differences are computed, so adding a point takes O(n) time.
+//
+// Panics if pt.X repeats the abscissa of an existing point.
+func (ni *NewtonInterpolator) AddPoint(pt Point) {
+
+	m := len(ni.x)
+
+	for _, v := range ni.x {
+		if v == pt.X {
+			log.Panicf("AddPoint: repeated abscissa %f.", pt.X)
+		}
+	}
+
+	row := make([]float64, m+1)
+	row[0] = pt.Y
+
+	for k := 1; k <= m; k++ {
+		row[k] = (row[k-1] - ni.row[k-1]) / (pt.X - ni.x[m-k])
+	}
+
+	ni.x = append(ni.x, pt.X)
+	ni.c = append(ni.c, row[m])
+	ni.row = row
+}
+
+// Len returns the number of interpolated points.
+func (ni *NewtonInterpolator) Len() int {
+
+	return len(ni.x)
+}
+
+// NewtonCoefficients returns the divided differences
+//
+// f[x[0]], f[x[0], x[1]], ..., f[x[0], ..., x[n-1]].
+func (ni *NewtonInterpolator) NewtonCoefficients() []float64 {
+
+	return append([]float64{}, ni.c...)
+}
+
+// At returns the value of the interpolant evaluated at x.
+//
+// Panics if no points have been added.
+func (ni *NewtonInterpolator) At(x float64) float64 {
+
+	n := len(ni.c)
+
+	if n == 0 {
+		log.Panic("At: no points.")
+	}
+
+	// Horner's scheme on the Newton form.
+	out := ni.c[n-1]
+	for k := n - 2; k >= 0; k-- {
+		out = out*(x-ni.x[k]) + ni.c[k]
+	}
+
+	return out
+}
+
+// Poly returns the interpolant in the monomial basis.
+//
+// Panics if no points have been added.
+func (ni *NewtonInterpolator) Poly() Poly {
+
+	if len(ni.c) == 0 {
+		log.Panic("Poly: no points.")
+	}
+
+	return newtonToPoly(ni.x, ni.c)
+}
+
+// NewPolyHermiteInterpolate returns the Hermite interpolating polynomial p matching the given
+// values and derivatives.
+//
+// For each i, derivs[i] holds the value of f at x[i] followed by its successive derivatives
+// there, and p satisfies p^(k)(x[i]) = derivs[i][k] for every k. If N is the total number of
+// values given, p has degree at most N - 1.
+//
+// Panics if x is empty, if len(x) != len(derivs), if any derivs[i] is empty, or for repeated
+// abscissas.
+func NewPolyHermiteInterpolate(x []float64, derivs [][]float64) Poly {
+
+	if len(x) == 0 {
+		log.Panic("NewPolyHermiteInterpolate: empty x.")
+	}
+
+	if len(x) != len(derivs) {
+		log.Panicf("NewPolyHermiteInterpolate: %d abscissas but %d derivative lists.",
+			len(x), len(derivs))
+	}
+
+	checkDistinctNodes("NewPolyHermiteInterpolate", x)
+
+	// Repeat each node once per given value.
+	z := []float64{}
+	src := []int{}
+	for i, d := range derivs {
+		if len(d) == 0 {
+			log.Panicf("NewPolyHermiteInterpolate: no values given at %f.", x[i])
+		}
+
+		for range d {
+			z = append(z, x[i])
+			src = append(src, i)
+		}
+	}
+
+	n := len(z)
+
+	// Generalized divided differences, computed column by column in place:
+	// after step k, dd[j] = f[z[j], ..., z[j + k]].
+	dd := make([]float64, n)
+	for j := range dd {
+		dd[j] = derivs[src[j]][0]
+	}
+
+	c := make([]float64, n)
+	c[0] = dd[0]
+
+	for k := 1; k < n; k++ {
+		for j := 0; j+k < n; j++ {
+			if z[j] == z[j+k] {
+				// Confluent nodes: f[z, ..., z] (k + 1 times) = f^(k)(z) / k!.
+				dd[j] = derivs[src[j]][k] / fact(k)
+			} else {
+				dd[j] = (dd[j+1] - dd[j]) / (z[j+k] - z[j])
+			}
+		}
+
+		c[k] = dd[0]
+	}
+
+	return newtonToPoly(z, c)
+}
+
+// InterpolateAt returns the value at x of the polynomial interpolating the given points.
+//
+// The second (true) barycentric formula is evaluated directly, which is far better conditioned
+// than expanding the interpolant with NewPolyInterpolate() and evaluating it.
+//
+// Panics for empty points or repeated abscissas.
+func InterpolateAt(points []Point, x float64) float64 {
+
+	if len(points) == 0 {
+		log.Panic("InterpolateAt: empty points.")
+	}
+
+	n := len(points)
+	xs := make([]float64, n)
+	for i, pt := range points {
+		xs[i] = pt.X
+	}
+
+	checkDistinctNodes("InterpolateAt", xs)
+
+	num, den := 0.0, 0.0
+
+	for j := 0; j < n; j++ {
+		if x == xs[j] {
+			return points[j].Y
+		}
+
+		w := 1.0
+		for k := 0; k < n; k++ {
+			if k != j {
+				w /= xs[j] - xs[k]
+			}
+		}
+
+		t := w / (x - xs[j])
+		num += t * points[j].Y
+		den += t
+	}
+
+	return num / den
+}
@@ -0,0 +1,124 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in interpolate.go.
+*/
+
+func Test_NewPolyInterpolatePanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyInterpolate([]Point{}) })
+	assert.Panics(t, func() { NewPolyInterpolate([]Point{{1, 2}, {1, 3}}) })
+	assert.Panics(t, func() { InterpolateAt([]Point{}, 0) })
+	assert.Panics(t, func() { NewNewtonInterpolator([]Point{{1, 2}, {1, 3}}) })
+	assert.Panics(t, func() { NewNewtonInterpolator([]Point{}).At(0) })
+	assert.Panics(t, func() { NewNewtonInterpolator([]Point{}).Poly() })
+}
+
+func Test_NewPolyInterpolate(t *testing.T) {
+	testCases := []struct {
+		name string
+		arg  []Point
+		want Poly
+	}{
+		{
+			name: "single point",
+			arg:  []Point{{3, 7}},
+			want: NewPolyConst(7),
+		},
+		{
+			name: "line",
+			arg:  []Point{{0, 1}, {2, 5}},
+			want: NewPolyLinear(2, 1),
+		},
+		{
+			name: "parabola",
+			arg:  []Point{{-1, 1}, {0, 0}, {1, 1}},
+			want: NewPoly([]float64{1, 0, 0}),
+		},
+		{
+			name: "cubic, unordered",
+			arg:  []Point{{2, 3}, {-1, -3}, {0, 1}, {1, 1}},
+			want: NewPoly([]float64{1, -2, 1, 1}),
+		},
+		{
+			name: "collinear points give lower degree",
+			arg:  []Point{{0, 0}, {1, 1}, {2, 2}},
+			want: NewPolyLinear(1, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewPolyInterpolate(tc.arg)
+
+			assert.True(t, tc.want.Sub(got).chop(1e-12).IsZero(), "got %v", got)
+
+			for _, pt := range tc.arg {
+				assert.InDelta(t, pt.Y, NewNewtonInterpolator(tc.arg).At(pt.X), 1e-12)
+				assert.InDelta(t, tc.want.At(pt.X+0.5), InterpolateAt(tc.arg, pt.X+0.5), 1e-12)
+			}
+
+			newton := NewNewtonInterpolator(tc.arg).Poly()
+			assert.True(t, tc.want.Sub(newton).chop(1e-12).IsZero(), "got %v", newton)
+		})
+	}
+}
+
+func Test_NewtonInterpolatorAddPoint(t *testing.T) {
+
+	ni := NewNewtonInterpolator([]Point{{0, 1}})
+	assert.Equal(t, 1, ni.Len())
+	assert.Equal(t, NewPolyConst(1), ni.Poly())
+
+	// Interpolate 2^x one point at a time.
+	ni.AddPoint(Point{1, 2})
+	ni.AddPoint(Point{2, 4})
+	ni.AddPoint(Point{3, 8})
+
+	assert.Equal(t, 4, ni.Len())
+	assert.Equal(t, []float64{1, 1, 0.5, 1.0 / 6}, ni.NewtonCoefficients())
+	assert.InDelta(t, 8, ni.At(3), 1e-12)
+	assert.InDelta(t, 15, ni.At(4), 1e-12)
+
+	assert.Panics(t, func() { ni.AddPoint(Point{2, 0}) })
+}
+
+func Test_NewPolyHermiteInterpolatePanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyHermiteInterpolate([]float64{}, [][]float64{}) })
+	assert.Panics(t, func() { NewPolyHermiteInterpolate([]float64{1}, [][]float64{{1}, {2}}) })
+	assert.Panics(t, func() { NewPolyHermiteInterpolate([]float64{1}, [][]float64{{}}) })
+	assert.Panics(t, func() { NewPolyHermiteInterpolate([]float64{1, 1}, [][]float64{{1}, {2}}) })
+}
+
+func Test_NewPolyHermiteInterpolate(t *testing.T) {
+
+	// Value and slope at two points: the cubic x^3.
+	got := NewPolyHermiteInterpolate([]float64{0, 1}, [][]float64{{0, 0}, {1, 3}})
+	assert.True(t, NewPoly([]float64{1, 0, 0, 0}).Sub(got).chop(1e-12).IsZero(), "got %v", got)
+
+	// Taylor data only: exp around 0 to second order.
+	got = NewPolyHermiteInterpolate([]float64{0}, [][]float64{{1, 1, 1}})
+	assert.True(t, NewPoly([]float64{0.5, 1, 1}).Sub(got).chop(1e-12).IsZero(), "got %v", got)
+
+	// Mixed data for sin on [0, pi].
+	x := []float64{0, math.Pi / 2, math.Pi}
+	d := [][]float64{{0, 1}, {1}, {0, -1}}
+	p := NewPolyHermiteInterpolate(x, d)
+	dp := p.Derivative()
+
+	assert.Equal(t, 4, p.Degree())
+	for i := range x {
+		assert.InDelta(t, d[i][0], p.At(x[i]), 1e-12)
+		if len(d[i]) > 1 {
+			assert.InDelta(t, d[i][1], dp.At(x[i]), 1e-12)
+		}
+	}
+}
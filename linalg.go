@@ -0,0 +1,605 @@
+package polygo
+
+import (
+	"log"
+	"math"
+	"sort"
+)
+
+// newMatrix returns an m by n matrix of zeroes, stored row by row.
+func newMatrix(m, n int) [][]float64 {
+
+	a := make([][]float64, m)
+	for i := range a {
+		a[i] = make([]float64, n)
+	}
+
+	return a
+}
+
+// copyMatrix returns a deep copy of a.
+func copyMatrix(a [][]float64) [][]float64 {
+
+	c := make([][]float64, len(a))
+	for i := range a {
+		c[i] = append([]float64{}, a[i]...)
+	}
+
+	return c
+}
+
+// householderQR factors the m by n matrix a (m >= n) as a = QR in place using Householder
+// reflections.
+//
+// On return, the upper triangle of a holds R except for its diagonal, which is returned as rdiag.
+// The part of a below the diagonal holds the (unnormalized) Householder vectors, whose leading
+// entries are returned as v0, so that Q^T may be applied to a vector with applyQT().
+func householderQR(a [][]float64) (rdiag, v0 []float64) {
+
+	m, n := len(a), len(a[0])
+
+	rdiag = make([]float64, n)
+	v0 = make([]float64, n)
+
+	for k := 0; k < n; k++ {
+
+		// Norm of the column below (and including) the diagonal.
+		norm := 0.0
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, a[i][k])
+		}
+
+		if norm == 0 {
+			rdiag[k] = 0
+			v0[k] = 0
+			continue
+		}
+
+		// Reflect onto -sign(a[k][k]) * norm * e_k to avoid cancellation.
+		if a[k][k] > 0 {
+			norm = -norm
+		}
+
+		v0[k] = a[k][k] - norm
+		rdiag[k] = norm
+
+		// beta = 2 / (v^T v) with v = (v0[k], a[k+1][k], ..., a[m-1][k]).
+		vv := v0[k] * v0[k]
+		for i := k + 1; i < m; i++ {
+			vv += a[i][k] * a[i][k]
+		}
+
+		for j := k + 1; j < n; j++ {
+			dot := v0[k] * a[k][j]
+			for i := k + 1; i < m; i++ {
+				dot += a[i][k] * a[i][j]
+			}
+
+			f := 2 * dot / vv
+			a[k][j] -= f * v0[k]
+			for i := k + 1; i < m; i++ {
+				a[i][j] -= f * a[i][k]
+			}
+		}
+	}
+
+	return rdiag, v0
+}
+
+// applyQT overwrites b with Q^T b, where Q is given by the output of householderQR().
+func applyQT(a [][]float64, v0 []float64, b []float64) {
+
+	m, n := len(a), len(a[0])
+
+	for k := 0; k < n; k++ {
+		if v0[k] == 0 {
+			continue
+		}
+
+		vv := v0[k] * v0[k]
+		dot := v0[k] * b[k]
+		for i := k + 1; i < m; i++ {
+			vv += a[i][k] * a[i][k]
+			dot += a[i][k] * b[i]
+		}
+
+		f := 2 * dot / vv
+		b[k] -= f * v0[k]
+		for i := k + 1; i < m; i++ {
+			b[i] -= f * a[i][k]
+		}
+	}
+}
+
+// solveLeastSquares returns the x minimizing ||ax - b|| for the m by n matrix a (m >= n) of full
+// column rank, along with the diagonal of R in the QR factorization of a.
+//
+// Neither a nor b is modified.
+//
+// Panics if a has fewer rows than columns or is numerically rank deficient, i.e. if some diagonal
+// entry of R is at most 1e-16m times the largest in absolute value.
+func solveLeastSquares(a [][]float64, b []float64) ([]float64, []float64) {
+
+	m, n := len(a), len(a[0])
+
+	if m < n {
+		log.Panicf("solveLeastSquares: underdetermined %d by %d system.", m, n)
+	}
+
+	qr := copyMatrix(a)
+	rhs := append([]float64{}, b...)
+
+	rdiag, v0 := householderQR(qr)
+	applyQT(qr, v0, rhs)
+
+	tol := 1e-16 * float64(m) * maxAbs(rdiag)
+
+	// Back substitution on Rx = (Q^T b)[:n].
+	x := make([]float64, n)
+	for k := n - 1; k >= 0; k-- {
+		if math.Abs(rdiag[k]) <= tol {
+			log.Panic("solveLeastSquares: rank deficient matrix.")
+		}
+
+		s := rhs[k]
+		for j := k + 1; j < n; j++ {
+			s -= qr[k][j] * x[j]
+		}
+		x[k] = s / rdiag[k]
+	}
+
+	return x, rdiag
+}
+
+// solveLinear returns the solution x of the square system ax = b, using Gaussian elimination with
+// partial pivoting.
+//
+// Neither a nor b is modified.
+//
+// Panics if a is not square or is singular.
+func solveLinear(a [][]float64, b []float64) []float64 {
+
+	n := len(a)
+
+	if n == 0 || len(a[0]) != n || len(b) != n {
+		log.Panic("solveLinear: system is not square.")
+	}
+
+	lu := copyMatrix(a)
+	x := append([]float64{}, b...)
+
+	for k := 0; k < n; k++ {
+
+		// Choose the largest pivot in column k.
+		piv := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i][k]) > math.Abs(lu[piv][k]) {
+				piv = i
+			}
+		}
+
+		if lu[piv][k] == 0 {
+			log.Panic("solveLinear: singular matrix.")
+		}
+
+		lu[k], lu[piv] = lu[piv], lu[k]
+		x[k], x[piv] = x[piv], x[k]
+
+		for i := k + 1; i < n; i++ {
+			f := lu[i][k] / lu[k][k]
+			for j := k; j < n; j++ {
+				lu[i][j] -= f * lu[k][j]
+			}
+			x[i] -= f * x[k]
+		}
+	}
+
+	// Back substitution.
+	for k := n - 1; k >= 0; k-- {
+		for j := k + 1; j < n; j++ {
+			x[k] -= lu[k][j] * x[j]
+		}
+		x[k] /= lu[k][k]
+	}
+
+	return x
+}
+
+// determinant returns the determinant of the square matrix a, using Gaussian elimination with
+// partial pivoting.
+//
+// a is not modified.
+//
+// Panics if a is not square.
+func determinant(a [][]float64) float64 {
+
+	n := len(a)
+
+	for _, row := range a {
+		if len(row) != n {
+			log.Panic("determinant: matrix is not square.")
+		}
+	}
+
+	lu := copyMatrix(a)
+	det := 1.0
+
+	for k := 0; k < n; k++ {
+
+		// Choose the largest pivot in column k.
+		piv := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i][k]) > math.Abs(lu[piv][k]) {
+				piv = i
+			}
+		}
+
+		if lu[piv][k] == 0 {
+			return 0
+		}
+
+		if piv != k {
+			lu[k], lu[piv] = lu[piv], lu[k]
+			det = -det
+		}
+
+		det *= lu[k][k]
+
+		for i := k + 1; i < n; i++ {
+			f := lu[i][k] / lu[k][k]
+			for j := k; j < n; j++ {
+				lu[i][j] -= f * lu[k][j]
+			}
+		}
+	}
+
+	return det
+}
+
+// symTridiagEigen returns the eigenvalues of the symmetric tridiagonal matrix with diagonal d and
+// off-diagonal e (e[i] is the entry between rows i and i + 1), in increasing order, together with
+// the first component of each corresponding unit eigenvector.
+//
+// The implicit QL algorithm with Wilkinson shifts is used. Neither d nor e is modified.
+//
+// Panics if len(e) != len(d) - 1 or if the iteration fails to converge.
+func symTridiagEigen(d, e []float64) ([]float64, []float64) {
+
+	n := len(d)
+
+	if len(e) != n-1 {
+		log.Panicf("symTridiagEigen: %d diagonal but %d off-diagonal entries.", n, len(e))
+	}
+
+	d = append([]float64{}, d...)
+	e = append(append([]float64{}, e...), 0)
+
+	// First row of the accumulated rotations.
+	z := make([]float64, n)
+	z[0] = 1
+
+	for l := 0; l < n; l++ {
+		for iter := 0; ; iter++ {
+
+			// Look for a negligible off-diagonal entry to split the matrix.
+			m := l
+			for ; m < n-1; m++ {
+				dd := math.Abs(d[m]) + math.Abs(d[m+1])
+				if math.Abs(e[m]) <= 1e-16*dd {
+					break
+				}
+			}
+
+			if m == l {
+				break
+			}
+
+			if iter == 60 {
+				log.Panic("symTridiagEigen: no convergence.")
+			}
+
+			// Wilkinson shift.
+			g := (d[l+1] - d[l]) / (2 * e[l])
+			r := math.Hypot(g, 1)
+			g = d[m] - d[l] + e[l]/(g+math.Copysign(r, g))
+
+			s, c, p := 1.0, 1.0, 0.0
+			deflated := false
+
+			for i := m - 1; i >= l; i-- {
+				f := s * e[i]
+				b := c * e[i]
+				r = math.Hypot(f, g)
+				e[i+1] = r
+
+				if r == 0 {
+					// Recover from underflow.
+					d[i+1] -= p
+					e[m] = 0
+					deflated = true
+					break
+				}
+
+				s = f / r
+				c = g / r
+				g = d[i+1] - p
+				r = (d[i]-g)*s + 2*c*b
+				p = s * r
+				d[i+1] = g + p
+				g = c*r - b
+
+				f = z[i+1]
+				z[i+1] = s*z[i] + c*f
+				z[i] = c*z[i] - s*f
+			}
+
+			if deflated {
+				continue
+			}
+
+			d[l] -= p
+			e[l] = g
+			e[m] = 0
+		}
+	}
+
+	// Sort by eigenvalue.
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return d[idx[i]] < d[idx[j]] })
+
+	eig := make([]float64, n)
+	first := make([]float64, n)
+	for i, k := range idx {
+		eig[i] = d[k]
+		first[i] = z[k]
+	}
+
+	return eig, first
+}
+
+// balance scales the rows and columns of the square matrix a in place by powers of 2, so that each
+// row and the corresponding column have comparable norms. This is a similarity transformation
+// which preserves the eigenvalues exactly but can greatly reduce their sensitivity to rounding
+// errors.
+func balance(a [][]float64) {
+
+	n := len(a)
+
+	for done := false; !done; {
+		done = true
+
+		for i := 0; i < n; i++ {
+			c, r := 0.0, 0.0
+			for j := 0; j < n; j++ {
+				if j != i {
+					c += math.Abs(a[j][i])
+					r += math.Abs(a[i][j])
+				}
+			}
+
+			if c == 0 || r == 0 {
+				continue
+			}
+
+			// Find the power of 2 that brings c closest to r.
+			f, s := 1.0, c+r
+			for g := r / 2; c < g; {
+				f *= 2
+				c *= 4
+			}
+			for g := r * 2; c > g; {
+				f /= 2
+				c /= 4
+			}
+
+			if (c+r)/f < 0.95*s {
+				done = false
+				for j := 0; j < n; j++ {
+					a[i][j] /= f
+					a[j][i] *= f
+				}
+			}
+		}
+	}
+}
+
+// hessenbergEigen returns the eigenvalues of the upper Hessenberg matrix a, in no particular
+// order. Complex conjugate pairs are returned next to each other.
+//
+// The Francis double shift QR algorithm is used, with exceptional shifts after 10 and 20
+// iterations without deflation. a is overwritten.
+//
+// Panics if the iteration fails to converge.
+func hessenbergEigen(a [][]float64) []complex128 {
+
+	n := len(a)
+	eig := make([]complex128, n)
+
+	// Used to judge negligible subdiagonal entries when the neighbouring diagonal vanishes.
+	anorm := 0.0
+	for i := 0; i < n; i++ {
+		for j := i - 1; j < n; j++ {
+			if j >= 0 {
+				anorm += math.Abs(a[i][j])
+			}
+		}
+	}
+
+	var p, q, r, s, w, x, y, z float64
+
+	// t accumulates the exceptional shifts.
+	t := 0.0
+
+	for nn := n - 1; nn >= 0; {
+		its := 0
+
+		for {
+			// Look for a single small subdiagonal entry.
+			l := nn
+			for ; l >= 1; l-- {
+				s = math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0 {
+					s = anorm
+				}
+				if math.Abs(a[l][l-1])+s == s {
+					a[l][l-1] = 0
+					break
+				}
+			}
+
+			x = a[nn][nn]
+
+			if l == nn {
+				// One root found.
+				eig[nn] = complex(x+t, 0)
+				nn--
+				break
+			}
+
+			y = a[nn-1][nn-1]
+			w = a[nn][nn-1] * a[nn-1][nn]
+
+			if l == nn-1 {
+				// Two roots found, from the trailing 2 by 2 block.
+				p = 0.5 * (y - x)
+				q = p*p + w
+				z = math.Sqrt(math.Abs(q))
+				x += t
+
+				if q >= 0 {
+					z = p + math.Copysign(z, p)
+					eig[nn-1], eig[nn] = complex(x+z, 0), complex(x+z, 0)
+					if z != 0 {
+						eig[nn] = complex(x-w/z, 0)
+					}
+				} else {
+					eig[nn-1], eig[nn] = complex(x+p, -z), complex(x+p, z)
+				}
+
+				nn -= 2
+				break
+			}
+
+			if its == 60 {
+				log.Panic("hessenbergEigen: no convergence.")
+			}
+
+			if its == 10 || its == 20 {
+				// Exceptional shift.
+				t += x
+				for i := 0; i <= nn; i++ {
+					a[i][i] -= x
+				}
+				s = math.Abs(a[nn][nn-1]) + math.Abs(a[nn-1][nn-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			its++
+
+			// Form the shift and look for two consecutive small subdiagonal entries.
+			m := nn - 2
+			for ; m >= l; m-- {
+				z = a[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/a[m+1][m] + a[m][m+1]
+				q = a[m+1][m+1] - z - r - s
+				r = a[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+
+				if m == l {
+					break
+				}
+
+				u := math.Abs(a[m][m-1]) * (math.Abs(q) + math.Abs(r))
+				v := math.Abs(p) * (math.Abs(a[m-1][m-1]) + math.Abs(z) + math.Abs(a[m+1][m+1]))
+				if u+v == v {
+					break
+				}
+			}
+
+			for i := m + 2; i <= nn; i++ {
+				a[i][i-2] = 0
+				if i != m+2 {
+					a[i][i-3] = 0
+				}
+			}
+
+			// Double shift QR step on rows l to nn and columns m to nn.
+			for k := m; k <= nn-1; k++ {
+				if k != m {
+					p = a[k][k-1]
+					q = a[k+1][k-1]
+					r = 0
+					if k != nn-1 {
+						r = a[k+2][k-1]
+					}
+
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x != 0 {
+						p /= x
+						q /= x
+						r /= x
+					}
+				}
+
+				s = math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+				if s == 0 {
+					continue
+				}
+
+				if k == m {
+					if l != m {
+						a[k][k-1] = -a[k][k-1]
+					}
+				} else {
+					a[k][k-1] = -s * x
+				}
+
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				// Row modification.
+				for j := k; j <= nn; j++ {
+					p = a[k][j] + q*a[k+1][j]
+					if k != nn-1 {
+						p += r * a[k+2][j]
+						a[k+2][j] -= p * z
+					}
+					a[k+1][j] -= p * y
+					a[k][j] -= p * x
+				}
+
+				// Column modification.
+				mmin := nn
+				if k+3 < nn {
+					mmin = k + 3
+				}
+
+				for i := l; i <= mmin; i++ {
+					p = x*a[i][k] + y*a[i][k+1]
+					if k != nn-1 {
+						p += z * a[i][k+2]
+						a[i][k+2] -= p * r
+					}
+					a[i][k+1] -= p * q
+					a[i][k] -= p
+				}
+			}
+		}
+	}
+
+	return eig
+}
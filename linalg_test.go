@@ -0,0 +1,177 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in linalg.go.
+*/
+
+func Test_solveLeastSquaresPanic(t *testing.T) {
+
+	assert.Panics(t, func() { solveLeastSquares([][]float64{{1, 2}}, []float64{1}) })
+	assert.Panics(t, func() { solveLeastSquares([][]float64{{1, 0}, {2, 0}}, []float64{1, 2}) })
+
+	// Rank deficient up to rounding errors.
+	assert.Panics(t, func() { solveLeastSquares([][]float64{{1, 1}, {1, 1 + 1e-17}}, []float64{1, 2}) })
+	assert.Panics(t, func() { solveLeastSquares([][]float64{{0.1, 0.3}, {0.2, 0.6}}, []float64{1, 2}) })
+}
+
+func Test_solveLeastSquares(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    [][]float64
+		b    []float64
+		want []float64
+	}{
+		{
+			name: "square",
+			a:    [][]float64{{2, 1}, {1, 3}},
+			b:    []float64{3, 5},
+			want: []float64{0.8, 1.4},
+		},
+		{
+			name: "consistent overdetermined",
+			a:    [][]float64{{1, 0}, {0, 1}, {1, 1}},
+			b:    []float64{1, 2, 3},
+			want: []float64{1, 2},
+		},
+		{
+			name: "inconsistent overdetermined",
+			a:    [][]float64{{1}, {1}, {1}},
+			b:    []float64{1, 2, 6},
+			want: []float64{3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := copyMatrix(tc.a)
+			b := append([]float64{}, tc.b...)
+
+			got, _ := solveLeastSquares(a, b)
+
+			assert.InDeltaSlice(t, tc.want, got, 1e-12)
+			assert.Equal(t, tc.a, a)
+			assert.Equal(t, tc.b, b)
+		})
+	}
+}
+
+func Test_solveLinearPanic(t *testing.T) {
+
+	assert.Panics(t, func() { solveLinear([][]float64{}, []float64{}) })
+	assert.Panics(t, func() { solveLinear([][]float64{{1, 2}}, []float64{1}) })
+	assert.Panics(t, func() { solveLinear([][]float64{{1, 2}, {2, 4}}, []float64{1, 2}) })
+}
+
+func Test_solveLinear(t *testing.T) {
+
+	a := [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}
+	b := []float64{5, 4, 4}
+
+	got := solveLinear(a, b)
+
+	assert.InDeltaSlice(t, []float64{1, 2, 1}, got, 1e-12)
+	assert.Equal(t, [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}, a)
+	assert.Equal(t, []float64{5, 4, 4}, b)
+}
+
+func Test_determinant(t *testing.T) {
+
+	assert.Panics(t, func() { determinant([][]float64{{1, 2}}) })
+
+	a := [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}
+
+	assert.InDelta(t, 3, determinant(a), 1e-14)
+	assert.Equal(t, [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}, a)
+	assert.Equal(t, 0.0, determinant([][]float64{{1, 2}, {2, 4}}))
+	assert.Equal(t, 1.0, determinant([][]float64{}))
+	assert.Equal(t, -1.0, determinant([][]float64{{0, 1}, {1, 0}}))
+}
+
+func Test_symTridiagEigenPanic(t *testing.T) {
+
+	assert.Panics(t, func() { symTridiagEigen([]float64{1, 2}, []float64{}) })
+}
+
+func Test_symTridiagEigen(t *testing.T) {
+
+	// [[2, 1], [1, 2]] has eigenvalues 1 and 3 with eigenvectors (1, -1) and (1, 1).
+	eig, first := symTridiagEigen([]float64{2, 2}, []float64{1})
+
+	assert.InDeltaSlice(t, []float64{1, 3}, eig, 1e-15)
+	assert.InDelta(t, 0.5, first[0]*first[0], 1e-15)
+	assert.InDelta(t, 0.5, first[1]*first[1], 1e-15)
+
+	// The second difference matrix of order n has eigenvalues 2 - 2cos(k pi / (n + 1)).
+	n := 12
+	d := make([]float64, n)
+	e := make([]float64, n-1)
+	for i := range d {
+		d[i] = 2
+		if i < n-1 {
+			e[i] = -1
+		}
+	}
+
+	eig, first = symTridiagEigen(d, e)
+
+	sum := 0.0
+	for k := 1; k <= n; k++ {
+		assert.InDelta(t, 2-2*math.Cos(float64(k)*math.Pi/float64(n+1)), eig[k-1], 1e-14)
+		sum += first[k-1] * first[k-1]
+	}
+	assert.InDelta(t, 1, sum, 1e-14)
+
+	eig, first = symTridiagEigen([]float64{5}, []float64{})
+	assert.Equal(t, []float64{5}, eig)
+	assert.Equal(t, []float64{1}, first)
+}
+
+func Test_balance(t *testing.T) {
+
+	a := [][]float64{{1, 1e6}, {1e-6, 1}}
+	balance(a)
+
+	// Scaling by powers of 2 equalizes the off-diagonal entries up to a factor of 2.
+	assert.Equal(t, 1.0, a[0][0])
+	assert.Equal(t, 1.0, a[1][1])
+	assert.InDelta(t, 1, a[0][1]*a[1][0], 1e-15)
+	assert.True(t, a[0][1]/a[1][0] <= 4 && a[1][0]/a[0][1] <= 4)
+}
+
+func Test_hessenbergEigen(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    [][]float64
+		want []complex128
+	}{
+		{name: "1 by 1", a: [][]float64{{3}}, want: []complex128{3}},
+		{name: "rotation", a: [][]float64{{0, -1}, {1, 0}}, want: []complex128{-1i, 1i}},
+		{name: "triangular", a: [][]float64{{1, 2, 3}, {0, 4, 5}, {0, 0, 6}}, want: []complex128{1, 4, 6}},
+		{name: "symmetric", a: [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+			want: []complex128{complex(2-math.Sqrt2, 0), 2, complex(2+math.Sqrt2, 0)}},
+		{name: "companion", a: [][]float64{{0, 0, 0, -1}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}},
+			want: []complex128{
+				complex(-math.Sqrt2/2, -math.Sqrt2/2), complex(-math.Sqrt2/2, math.Sqrt2/2),
+				complex(math.Sqrt2/2, -math.Sqrt2/2), complex(math.Sqrt2/2, math.Sqrt2/2)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := hessenbergEigen(copyMatrix(tc.a))
+			sortComplex(got)
+
+			assert.Len(t, got, len(tc.want))
+			for i := range tc.want {
+				assert.InDelta(t, real(tc.want[i]), real(got[i]), 1e-12)
+				assert.InDelta(t, imag(tc.want[i]), imag(got[i]), 1e-12)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+var (
+	minimaxIterations = 100
+	minimaxTolerance  = 1e-9
+)
+
+// chebBasis returns T_0(t), ..., T_n(t).
+func chebBasis(t float64, n int) []float64 {
+
+	T := make([]float64, n+1)
+	T[0] = 1
+
+	if n > 0 {
+		T[1] = t
+	}
+
+	for k := 2; k <= n; k++ {
+		T[k] = 2*t*T[k-1] - T[k-2]
+	}
+
+	return T
+}
+
+// refine_extremum returns the point of [l, r] at which |e| is largest, starting from the best
+// known point x0 and refining by golden-section search.
+func refine_extremum(e func(float64) float64, l, r, x0 float64) float64 {
+
+	invPhi := (math.Sqrt(5) - 1) / 2
+
+	x1 := r - invPhi*(r-l)
+	x2 := l + invPhi*(r-l)
+	v1, v2 := math.Abs(e(x1)), math.Abs(e(x2))
+
+	for i := 0; i < 100 && r-l > 1e-15*math.Max(1, math.Abs(x0)); i++ {
+		if v1 > v2 {
+			r, x2, v2 = x2, x1, v1
+			x1 = r - invPhi*(r-l)
+			v1 = math.Abs(e(x1))
+		} else {
+			l, x1, v1 = x1, x2, v2
+			x2 = l + invPhi*(r-l)
+			v2 = math.Abs(e(x2))
+		}
+	}
+
+	// The starting point may still beat the refinement, e.g. at an endpoint.
+	if x := (l + r) / 2; math.Abs(e(x)) > math.Abs(e(x0)) {
+		return x
+	}
+
+	return x0
+}
+
+// alternating_extrema returns the local extrema of |e| on [a, b] with alternating signs of e.
+//
+// e is sampled on the given (increasing) grid, which is split into runs on which e has constant
+// sign. The extremum of each run is refined with refine_extremum().
+func alternating_extrema(e func(float64) float64, grid []float64) []float64 {
+
+	vals := make([]float64, len(grid))
+	for i, x := range grid {
+		vals[i] = e(x)
+	}
+
+	opposite := func(u, v float64) bool { return u > 0 && v < 0 || u < 0 && v > 0 }
+
+	ext := []float64{}
+
+	for start := 0; start < len(grid); {
+
+		// Extend the run while the sign of e is unchanged (zeros join the current run).
+		end, best, sign := start, start, vals[start]
+		for end+1 < len(grid) && !opposite(sign, vals[end+1]) {
+			end++
+			if sign == 0 {
+				sign = vals[end]
+			}
+			if math.Abs(vals[end]) > math.Abs(vals[best]) {
+				best = end
+			}
+		}
+
+		l, r := grid[best], grid[best]
+		if best > 0 {
+			l = grid[best-1]
+		}
+		if best < len(grid)-1 {
+			r = grid[best+1]
+		}
+
+		ext = append(ext, refine_extremum(e, l, r, grid[best]))
+		start = end + 1
+	}
+
+	return ext
+}
+
+// trim_extrema removes points from the alternating extrema x of e until m remain, preserving the
+// alternation and the largest value of |e|.
+func trim_extrema(e func(float64) float64, x []float64, m int) []float64 {
+
+	for len(x) > m {
+
+		// Find the smallest extremum.
+		k := 0
+		for i := range x {
+			if math.Abs(e(x[i])) < math.Abs(e(x[k])) {
+				k = i
+			}
+		}
+
+		switch {
+		case k == 0 || k == len(x)-1:
+			x = append(x[:k], x[k+1:]...)
+
+		case len(x)-m == 1:
+			// Removing an interior point alone would break the alternation, so drop the smaller
+			// endpoint instead.
+			if math.Abs(e(x[0])) < math.Abs(e(x[len(x)-1])) {
+				x = x[1:]
+			} else {
+				x = x[:len(x)-1]
+			}
+
+		default:
+			// Remove the point together with its smaller neighbour.
+			if math.Abs(e(x[k-1])) < math.Abs(e(x[k+1])) {
+				k--
+			}
+			x = append(x[:k], x[k+2:]...)
+		}
+	}
+
+	return x
+}
+
+// NewPolyMinimax returns the polynomial p of degree at most n that best approximates f on [a, b] in
+// the uniform norm, i.e. the p minimizing the maximum of |f(x) - p(x)| over [a, b].
+//
+// Also returned are the achieved maximum error and the n + 2 equioscillation points, in increasing
+// order, at which f - p attains that error with alternating signs.
+//
+// The Remez exchange algorithm is used, starting from the Chebyshev extrema and working in the
+// Chebyshev basis (see ChebPoly) to keep the linear systems well conditioned. The exchange stops
+// once the levelled error agrees with the true maximum error to the relative tolerance set by
+// SetMinimaxTolerance() (1e-9 by default), or after the number of iterations set by
+// SetMinimaxIterations(). f should be continuous on [a, b].
+//
+// Panics if a >= b, for negative n, or if f is not finite at an evaluated point.
+func NewPolyMinimax(f func(float64) float64, a, b float64, n int) (Poly, float64, []float64) {
+
+	if !(a < b) {
+		log.Panicf("NewPolyMinimax: invalid interval [%f, %f].", a, b)
+	}
+
+	if n < 0 {
+		log.Panic("NewPolyMinimax: negative n.")
+	}
+
+	fx := func(x float64) float64 {
+		y := f(x)
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			log.Panicf("NewPolyMinimax: f(%f) = %f.", x, y)
+		}
+		return y
+	}
+
+	toT := func(x float64) float64 { return (2*x - a - b) / (b - a) }
+
+	// Initial reference: the extrema of T_(n+1) on [a, b].
+	m := n + 2
+	ref := make([]float64, m)
+	for i := range ref {
+		ref[i] = ((a + b) - (b-a)*math.Cos(float64(i)*math.Pi/float64(m-1))) / 2
+	}
+	ref[0], ref[m-1] = a, b
+
+	for iter := 0; ; iter++ {
+
+		// Solve sum c[j]T_j(t[i]) + (-1)^i E = f(x[i]) for c and the levelled error E.
+		sys := newMatrix(m, m)
+		rhs := make([]float64, m)
+
+		for i, x := range ref {
+			copy(sys[i], chebBasis(toT(x), n))
+			sys[i][m-1] = 1 - 2*float64(i%2)
+			rhs[i] = fx(x)
+		}
+
+		sol := solveLinear(sys, rhs)
+		p := newChebPolyNoReverse(sol[:m-1], a, b)
+		level := math.Abs(sol[m-1])
+
+		e := func(x float64) float64 { return fx(x) - p.At(x) }
+
+		// Sample e between consecutive reference points, so that the grid follows their clustering,
+		// and exchange the reference for the largest alternating extrema.
+		grid := []float64{a}
+		pts := append(append([]float64{a}, ref...), b)
+		for i := 1; i < len(pts); i++ {
+			if pts[i] == pts[i-1] {
+				continue
+			}
+			for j := 1; j <= 16; j++ {
+				grid = append(grid, pts[i-1]+float64(j)*(pts[i]-pts[i-1])/16)
+			}
+		}
+
+		newRef := alternating_extrema(e, grid)
+
+		// With too few alternations, the reference is degenerate. This happens when f and [a, b]
+		// are symmetric, so that E = 0 and p interpolates f at the reference. Break the symmetry by
+		// moving every point but a towards its left neighbour.
+		short := len(newRef) < m
+		if short {
+			newRef = make([]float64, m)
+			newRef[0] = ref[0]
+			for i := 1; i < m; i++ {
+				newRef[i] = ref[i] - (ref[i]-ref[i-1])/4
+			}
+		} else {
+			newRef = trim_extrema(e, newRef, m)
+		}
+
+		// The maximum error over the whole grid, as well as at the refined extrema.
+		maxErr := 0.0
+		for _, x := range append(grid, newRef...) {
+			maxErr = math.Max(maxErr, math.Abs(e(x)))
+		}
+
+		// f - p is (numerically) zero, e.g. when f is a polynomial of degree at most n.
+		if maxErr <= 1e-15*maxAbs(rhs) {
+			return p.ToPoly(), maxErr, ref
+		}
+
+		if !short && maxErr-level <= minimaxTolerance*maxErr || iter == minimaxIterations {
+			return p.ToPoly(), maxErr, newRef
+		}
+
+		ref = newRef
+	}
+}
+
+// SetMinimaxIterations sets the maximum number of exchange steps taken by NewPolyMinimax() to v.
+//
+// Panics for negative v.
+func SetMinimaxIterations(v int) {
+	if v < 0 {
+		log.Panic("SetMinimaxIterations: negative v.")
+	}
+
+	minimaxIterations = v
+}
+
+// SetMinimaxTolerance sets the relative gap between the levelled and the true maximum error at
+// which NewPolyMinimax() stops exchanging to v.
+//
+// Panics for negative v.
+func SetMinimaxTolerance(v float64) {
+	if v < 0 {
+		log.Panic("SetMinimaxTolerance: negative v.")
+	}
+
+	minimaxTolerance = v
+}
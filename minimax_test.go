@@ -0,0 +1,84 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in minimax.go.
+*/
+
+func Test_NewPolyMinimaxPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyMinimax(math.Exp, 1, 0, 3) })
+	assert.Panics(t, func() { NewPolyMinimax(math.Exp, 0, 1, -1) })
+	assert.Panics(t, func() { NewPolyMinimax(math.Log, -1, 1, 3) })
+	assert.Panics(t, func() { SetMinimaxIterations(-1) })
+	assert.Panics(t, func() { SetMinimaxTolerance(-1) })
+}
+
+func Test_NewPolyMinimax(t *testing.T) {
+	testCases := []struct {
+		name    string
+		f       func(float64) float64
+		a, b    float64
+		n       int
+		wantErr float64
+	}{
+		{name: "exp, degree 1", f: math.Exp, a: 0, b: 1, n: 1, wantErr: 0.1059334163},
+		{name: "exp, degree 3", f: math.Exp, a: -1, b: 1, n: 3, wantErr: 0.0055283},
+		{name: "abs, degree 2", f: math.Abs, a: -1, b: 1, n: 2, wantErr: 0.125},
+		{name: "sin, degree 7", f: math.Sin, a: -math.Pi, b: math.Pi, n: 7, wantErr: 0},
+		{name: "atan, degree 10", f: math.Atan, a: 0, b: 1, n: 10, wantErr: 0},
+
+		// Symmetric cases, where the first levelled error is 0.
+		{name: "cos, degree 0", f: math.Cos, a: -1, b: 1, n: 0, wantErr: 0.2298488471},
+		{name: "cos, degree 2", f: math.Cos, a: -1, b: 1, n: 2, wantErr: 0.0049536320},
+		{name: "cos, degree 4", f: math.Cos, a: -1, b: 1, n: 4, wantErr: 0.0000418775},
+		{name: "sin, degree 3", f: math.Sin, a: -math.Pi, b: math.Pi, n: 3, wantErr: 0.1047308434},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, maxErr, pts := NewPolyMinimax(tc.f, tc.a, tc.b, tc.n)
+
+			assert.LessOrEqual(t, p.Degree(), tc.n)
+			assert.Len(t, pts, tc.n+2)
+
+			if tc.wantErr != 0 {
+				assert.InDelta(t, tc.wantErr, maxErr, 1e-6)
+			}
+
+			// The error equioscillates at the returned points.
+			for i, x := range pts {
+				e := tc.f(x) - p.At(x)
+				assert.InDelta(t, maxErr, math.Abs(e), 1e-6*maxErr)
+
+				if i > 0 {
+					assert.True(t, x > pts[i-1])
+					ePrev := tc.f(pts[i-1]) - p.At(pts[i-1])
+					assert.True(t, e*ePrev < 0)
+				}
+			}
+
+			// ...and is no larger anywhere else.
+			for x := tc.a; x <= tc.b; x += (tc.b - tc.a) / 1000 {
+				assert.LessOrEqual(t, math.Abs(tc.f(x)-p.At(x)), maxErr*(1+1e-6))
+			}
+		})
+	}
+
+	// A minimax approximation beats the Taylor polynomial of the same degree.
+	_, maxErr, _ := NewPolyMinimax(math.Sin, -math.Pi, math.Pi, 7)
+	taylor := NewPolyTaylorSin(7, 0)
+	assert.Less(t, maxErr, math.Abs(math.Sin(math.Pi)-taylor.At(math.Pi)))
+
+	// Polynomials of degree at most n are reproduced.
+	q := NewPoly([]float64{2, 0, -1})
+	p, maxErr, _ := NewPolyMinimax(q.At, -3, 2, 3)
+	assert.InDelta(t, 0, maxErr, 1e-12)
+	assert.InDeltaSlice(t, q.Coefficients(), p.Coefficients(), 1e-10)
+}
@@ -323,44 +323,196 @@ func NewPolyTaylorSin(n int, a float64) Poly {
 	return sum
 }
 
-// NewPolyChebyshev1 returns the nth Chebyshev polynomial of the first kind.
+// newPolyTaylorCoef returns the polynomial
+//
+// p(x) = c[0] + c[1](x - a) + c[2](x - a)^2 + ... + c[n - 1](x - a)^(n - 1),
+//
+// where n = len(c).
+func newPolyTaylorCoef(c []float64, a float64) Poly {
+
+	// If q(y) = p(y + a), then p(x) = q(x - a).
+	return newPolyNoReverse(append([]float64{}, c...)).Shift(-a)
+}
+
+// NewPolyTaylor returns the Taylor polynomial centered at a of a function f with the given
+// derivative values at a.
+//
+// Let d = derivs and let n = len(d) - 1. Then, d[k] is the kth derivative of f at a and
+//
+//   - p(x) = d[0] + d[1](x - a) + d[2](x - a)^2 / 2! + ... + d[n](x - a)^n / n!.
+//
+// Panics for empty derivs.
+func NewPolyTaylor(derivs []float64, a float64) Poly {
+
+	if len(derivs) == 0 {
+		log.Panic("NewPolyTaylor: empty derivs.")
+	}
+
+	c := make([]float64, len(derivs))
+	for k, d := range derivs {
+		c[k] = d / fact(k)
+	}
+
+	return newPolyTaylorCoef(c, a)
+}
+
+// NewPolyTaylorCos returns the Taylor polynomial of the cosine function centered at a with degree
+// n.
 //
 // Panics for negative n.
-func NewPolyChebyshev1(n int) Poly {
+func NewPolyTaylorCos(n int, a float64) Poly {
 
 	if n < 0 {
-		log.Panic("NewPolyChebyshev1: negative n.")
+		log.Panic("NewPolyTaylorCos: negative n.")
 	}
 
-	if n == 0 {
-		return NewPolyConst(1)
+	sina := math.Sin(a)
+	cosa := math.Cos(a)
+
+	derivCycle := [4]float64{
+		cosa,
+		-sina,
+		-cosa,
+		sina,
 	}
 
-	if n == 1 {
-		return NewPolyLinear(1, 0)
+	derivs := make([]float64, n+1)
+	for i := range derivs {
+		derivs[i] = derivCycle[i%4]
 	}
 
-	return NewPolyLinear(2, 0).Mul(NewPolyChebyshev1(n - 1)).Sub(NewPolyChebyshev1(n - 2))
+	return NewPolyTaylor(derivs, a)
 }
 
-// NewPolyChebyshev2 returns the nth Chebyshev polynomial of the second kind.
+// NewPolyTaylorExp returns the Taylor polynomial of the exponential function centered at a with
+// degree n.
 //
 // Panics for negative n.
-func NewPolyChebyshev2(n int) Poly {
+func NewPolyTaylorExp(n int, a float64) Poly {
 
 	if n < 0 {
-		log.Panic("NewPolyChebyshev2: negative n.")
+		log.Panic("NewPolyTaylorExp: negative n.")
 	}
 
-	if n == 0 {
-		return NewPolyConst(1)
+	// Every derivative of exp is exp.
+	c := make([]float64, n+1)
+	for i := range c {
+		c[i] = math.Exp(a) / fact(i)
+	}
+
+	return newPolyTaylorCoef(c, a)
+}
+
+// NewPolyTaylorLog returns the Taylor polynomial of the natural logarithm centered at a with degree
+// n.
+//
+// Panics for negative n or non-positive a.
+func NewPolyTaylorLog(n int, a float64) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyTaylorLog: negative n.")
+	}
+
+	if a <= 0 {
+		log.Panic("NewPolyTaylorLog: non-positive a.")
+	}
+
+	// The kth derivative of log at a is (-1)^(k - 1)(k - 1)! / a^k, so the factorials cancel.
+	c := make([]float64, n+1)
+	c[0] = math.Log(a)
+	if n > 0 {
+		c[1] = 1 / a
+		for k := 2; k <= n; k++ {
+			c[k] = -c[k-1] * float64(k-1) / (float64(k) * a)
+		}
+	}
+
+	return newPolyTaylorCoef(c, a)
+}
+
+// NewPolyTaylorAtan returns the Taylor polynomial of the arctangent function centered at a with
+// degree n.
+//
+// Panics for negative n.
+func NewPolyTaylorAtan(n int, a float64) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyTaylorAtan: negative n.")
+	}
+
+	// The coefficients g[k] of atan'(a + y) = 1 / (1 + (a + y)^2) satisfy
+	// (1 + a^2)g[k] + 2ag[k - 1] + g[k - 2] = 0 for k > 0, and atan has coefficients g[k - 1] / k.
+	g := make([]float64, n)
+	for k := range g {
+		switch k {
+		case 0:
+			g[k] = 1 / (1 + a*a)
+		case 1:
+			g[k] = -2 * a * g[0] / (1 + a*a)
+		default:
+			g[k] = -(2*a*g[k-1] + g[k-2]) / (1 + a*a)
+		}
 	}
 
-	if n == 1 {
-		return NewPolyLinear(2, 0)
+	c := make([]float64, n+1)
+	c[0] = math.Atan(a)
+	for k := 1; k <= n; k++ {
+		c[k] = g[k-1] / float64(k)
 	}
 
-	return NewPolyLinear(2, 0).Mul(NewPolyChebyshev2(n - 1)).Sub(NewPolyChebyshev2(n - 2))
+	return newPolyTaylorCoef(c, a)
+}
+
+// TaylorRemainderBound returns an upper bound on the error |f(x) - p(x)| for x in [l, r], where p
+// is the Taylor polynomial of f centered at a with degree n.
+//
+// m must bound the absolute value of the (n + 1)th derivative of f between a and every point of
+// [l, r]. The Lagrange form of the remainder then gives the bound
+//
+//   - m * max(|l - a|, |r - a|)^(n + 1) / (n + 1)!.
+//
+// Panics for negative n, negative m or invalid intervals.
+func TaylorRemainderBound(n int, a, l, r, m float64) float64 {
+
+	if n < 0 {
+		log.Panic("TaylorRemainderBound: negative n.")
+	}
+
+	if m < 0 {
+		log.Panic("TaylorRemainderBound: negative m.")
+	}
+
+	if r < l {
+		log.Panicf("TaylorRemainderBound: invalid interval [%f, %f].", l, r)
+	}
+
+	dist := math.Max(math.Abs(l-a), math.Abs(r-a))
+
+	return m * math.Pow(dist, float64(n+1)) / fact(n+1)
+}
+
+// NewPolyChebyshev1 returns the nth Chebyshev polynomial of the first kind.
+//
+// Panics for negative n.
+func NewPolyChebyshev1(n int) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyChebyshev1: negative n.")
+	}
+
+	return NewRecurrenceChebyshev1().Nth(n)
+}
+
+// NewPolyChebyshev2 returns the nth Chebyshev polynomial of the second kind.
+//
+// Panics for negative n.
+func NewPolyChebyshev2(n int) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyChebyshev2: negative n.")
+	}
+
+	return NewRecurrenceChebyshev2().Nth(n)
 }
 
 // NewPolyLegendre returns the nth Legendre polynomial.
@@ -372,10 +524,7 @@ func NewPolyLegendre(n int) Poly {
 		log.Panic("NewPolyLegendre: negative n.")
 	}
 
-	// Implement Rodrigues' formula.
-	ddxpn := NewPolyQuadratic(1, 0, -1).Pow(n).DerivativeN(n)
-
-	return ddxpn.MulScalar(1 / (fact(n) * math.Pow(2, float64(n))))
+	return NewRecurrenceLegendre().Nth(n)
 }
 
 // NewPolyLaguerre returns the nth Laguerre polynomial.
@@ -387,15 +536,78 @@ func NewPolyLaguerre(n int) Poly {
 		log.Panic("NewPolyLaguerre: negative n.")
 	}
 
-	coefs := make([]float64, n+1)
+	return NewRecurrenceLaguerreAssoc(0).Nth(n)
+}
+
+// NewPolyLaguerreAssoc returns the nth associated (generalized) Laguerre polynomial L_n^(alpha).
+//
+// For alpha = 0, this is the nth Laguerre polynomial (see NewPolyLaguerre()).
+//
+// Panics for negative n.
+func NewPolyLaguerreAssoc(n int, alpha float64) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyLaguerreAssoc: negative n.")
+	}
+
+	return NewRecurrenceLaguerreAssoc(alpha).Nth(n)
+}
+
+// NewPolyHermite returns the nth (physicists') Hermite polynomial H_n.
+//
+// Panics for negative n.
+func NewPolyHermite(n int) Poly {
 
-	sgn := 1.0
-	for k := 0; k <= n; k++ {
-		coefs[k] = choose(n, k) * sgn / fact(k)
-		sgn *= -1
+	if n < 0 {
+		log.Panic("NewPolyHermite: negative n.")
 	}
 
-	return newPolyNoReverse(coefs)
+	return NewRecurrenceHermite().Nth(n)
+}
+
+// NewPolyHermiteE returns the nth probabilists' Hermite polynomial He_n.
+//
+// Panics for negative n.
+func NewPolyHermiteE(n int) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyHermiteE: negative n.")
+	}
+
+	return NewRecurrenceHermiteE().Nth(n)
+}
+
+// NewPolyGegenbauer returns the nth Gegenbauer (ultraspherical) polynomial C_n^(lambda).
+//
+// For lambda = 1/2, this is the nth Legendre polynomial, and for lambda = 1, the nth Chebyshev
+// polynomial of the second kind.
+//
+// Panics for negative n.
+func NewPolyGegenbauer(n int, lambda float64) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyGegenbauer: negative n.")
+	}
+
+	return NewRecurrenceGegenbauer(lambda).Nth(n)
+}
+
+// NewPolyJacobi returns the nth Jacobi polynomial P_n^(alpha, beta).
+//
+// For alpha = beta = 0, this is the nth Legendre polynomial.
+//
+// Panics for negative n, or for alpha <= -1 or beta <= -1.
+func NewPolyJacobi(n int, alpha, beta float64) Poly {
+
+	if n < 0 {
+		log.Panic("NewPolyJacobi: negative n.")
+	}
+
+	if alpha <= -1 || beta <= -1 {
+		log.Panicf("NewPolyJacobi: parameters (%f, %f) not greater than -1.", alpha, beta)
+	}
+
+	return NewRecurrenceJacobi(alpha, beta).Nth(n)
 }
 
 // Coefficients returns the coefficients c of p ordered in decreasing degree.
@@ -514,59 +726,19 @@ func (p Poly) Monic() Poly {
 // At returns the value of p evaluated at x.
 func (p Poly) At(x float64) float64 {
 
-	// Implement Horner's scheme.
-	out := p.coef[p.deg]
-	for i := p.deg - 1; i >= 0; i-- {
-		out = out*x + p.coef[i]
-	}
-
-	return out
+	return float64(p.Generic().At(Real(x)))
 }
 
 // Add returns the polynomial sum p + q.
 func (p Poly) Add(q Poly) Poly {
 
-	var max int
-	if p.len > q.len {
-		max = p.len
-	} else {
-		max = q.len
-	}
-
-	// Pad the shorter polynomial with zeroes to align.
-	pe := expand(p.coef, max)
-	qe := expand(q.coef, max)
-
-	sumCoef := make([]float64, max)
-
-	// Add like terms.
-	for i := 0; i < max; i++ {
-		sumCoef[i] = pe[i] + qe[i]
-	}
-
-	return newPolyNoReverse(sumCoef)
+	return NewPolyFromGeneric(p.Generic().Add(q.Generic()))
 }
 
 // Sub returns the polynomial difference p - q.
 func (p Poly) Sub(q Poly) Poly {
 
-	var max int
-	if p.len > q.len {
-		max = p.len
-	} else {
-		max = q.len
-	}
-
-	pe := expand(p.coef, max)
-	qe := expand(q.coef, max)
-
-	difCoef := make([]float64, max)
-
-	for i := 0; i < max; i++ {
-		difCoef[i] = pe[i] - qe[i]
-	}
-
-	return newPolyNoReverse(difCoef)
+	return NewPolyFromGeneric(p.Generic().Sub(q.Generic()))
 }
 
 // MulScalar returns the scalar-polynomial product sp.
@@ -577,28 +749,13 @@ func (p Poly) MulScalar(s float64) Poly {
 		return NewPoly([]float64{0})
 	}
 
-	prodCoef := make([]float64, p.len)
-	for i, c := range p.coef {
-		prodCoef[i] = s * c
-	}
-
-	return newPolyNoReverse(prodCoef)
+	return NewPolyFromGeneric(p.Generic().MulScalar(Real(s)))
 }
 
 // Mul returns the polynomial product pq.
 func (p Poly) Mul(q Poly) Poly {
 
-	// The product m will have deg(m) = deg(p) + deg(q).
-	// We add 1 since degree is one less than length of the coefficient slice.
-	prodCoef := make([]float64, p.deg+q.deg+1)
-
-	for i := 0; i < p.len; i++ {
-		for j := 0; j < q.len; j++ {
-			prodCoef[i+j] += p.coef[i] * q.coef[j]
-		}
-	}
-
-	return newPolyNoReverse(prodCoef)
+	return NewPolyFromGeneric(p.Generic().Mul(q.Generic()))
 }
 
 // MulFast returns the polynomial product pq.
@@ -655,17 +812,7 @@ func (p Poly) MulFast(q Poly) Poly {
 // Panics for negative n.
 func (p Poly) Pow(n int) Poly {
 
-	if n < 0 {
-		log.Panic("Pow: negative n.")
-	}
-
-	prod := NewPolyConst(1)
-
-	for i := 0; i < n; i++ {
-		prod = prod.Mul(p)
-	}
-
-	return prod
+	return NewPolyFromGeneric(p.Generic().Pow(n))
 }
 
 // PowFast returns the polynomial power p^n.
@@ -693,47 +840,13 @@ func (p Poly) PowFast(n int) Poly {
 // Panics if q = 0.
 func (p Poly) Div(q Poly) (Poly, Poly) {
 
-	// Dividing by zero.
 	if q.IsZero() {
 		log.Panic("Div: division by zero polynomial.")
 	}
 
-	// Dividing zero.
-	if p.IsZero() {
-		return NewPolyZero(), NewPolyZero()
-	}
-
-	// Dividing by larger degree.
-	if p.deg < q.deg {
-		return NewPolyZero(), p
-	}
-
-	// Implement expanded synthetic division for non-monic divisors.
-
-	pRev := reverse(p.coef)
-	qRev := reverse(q.coef)
-
-	quoRemCoef := make([]float64, p.len)
-	copy(quoRemCoef, pRev)
-
-	lead := qRev[0]
-	sep := p.len - q.len + 1
-
-	for i := 0; i < sep; i++ {
-		quoRemCoef[i] /= lead
-
-		if c := quoRemCoef[i]; c != 0 {
-
-			for j := 1; j < q.len; j++ {
-				quoRemCoef[i+j] += -qRev[j] * c
-			}
-		}
-	}
-
-	quoCoef := reverse(quoRemCoef[:sep])
-	remCoef := reverse(quoRemCoef[sep:])
+	m, n := GenericDiv(p.Generic(), q.Generic())
 
-	return newPolyNoReverse(quoCoef), newPolyNoReverse(remCoef)
+	return NewPolyFromGeneric(m), NewPolyFromGeneric(n)
 }
 
 // Reciprocal returns the reciprocal polynomial p* of p.
@@ -748,29 +861,7 @@ func (p Poly) Reciprocal() Poly {
 // String returns a string representation of p in decreasing-degree sum form.
 func (p Poly) String() string {
 
-	var sb strings.Builder
-
-	sb.WriteString(fmt.Sprintf("[ %fx^{%d}", p.coef[p.deg], p.deg))
-
-	var sgn, strCoef string
-	for i := 1; i < p.len; i++ {
-
-		strCoef = fmt.Sprintf("%f", p.coef[p.deg-i])
-
-		if sign(p.coef[p.deg-i]) == -1 {
-			sgn = " - "
-			strCoef = strCoef[1:]
-		} else {
-			sgn = " + "
-		}
-
-		sb.WriteString(sgn)
-		sb.WriteString(fmt.Sprintf("%sx^{%d}", strCoef, p.deg-i))
-	}
-
-	sb.WriteString(" ]")
-
-	return sb.String()
+	return p.Generic().String()
 }
 
 // Stringn returns a string representation of p in decreasing-degree sum form with it's coefficients
@@ -834,3 +925,17 @@ func (p Poly) id() uint32 {
 
 	return h.Sum32()
 }
+
+// exactId returns an identifier for p built from the bits of its coefficients, so that distinct
+// polynomials never share one.
+func (p Poly) exactId() string {
+
+	var sb strings.Builder
+
+	for _, c := range p.coef {
+		sb.WriteString(strconv.FormatUint(math.Float64bits(c), 16))
+		sb.WriteByte(',')
+	}
+
+	return sb.String()
+}
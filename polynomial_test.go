@@ -542,6 +542,115 @@ func Test_NewPolyTaylorSin(t *testing.T) {
 	}
 }
 
+func Test_NewPolyTaylorPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyTaylor([]float64{}, 0) })
+	assert.Panics(t, func() { NewPolyTaylorCos(-1, 0) })
+	assert.Panics(t, func() { NewPolyTaylorExp(-1, 0) })
+	assert.Panics(t, func() { NewPolyTaylorLog(-1, 1) })
+	assert.Panics(t, func() { NewPolyTaylorLog(3, 0) })
+	assert.Panics(t, func() { NewPolyTaylorLog(3, -1) })
+	assert.Panics(t, func() { NewPolyTaylorAtan(-1, 0) })
+}
+
+func Test_NewPolyTaylor(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		argDerivs []float64
+		argA      float64
+		wantCoefs []float64
+	}{
+		{
+			name:      "constant",
+			argDerivs: []float64{3},
+			argA:      5,
+			wantCoefs: []float64{3},
+		},
+		{
+			name:      "Maclaurin",
+			argDerivs: []float64{1, 2, 6, 12},
+			argA:      0,
+			wantCoefs: []float64{1, 2, 3, 2},
+		},
+		{
+			name:      "x^2 at 1",
+			argDerivs: []float64{1, 2, 2},
+			argA:      1,
+			wantCoefs: []float64{0, 0, 1},
+		},
+		{
+			name:      "x^3 at -2",
+			argDerivs: []float64{-8, 12, -12, 6},
+			argA:      -2,
+			wantCoefs: []float64{0, 0, 0, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewPolyTaylor(tc.argDerivs, tc.argA)
+
+			assert.Equal(t, tc.wantCoefs, got.coef)
+		})
+	}
+}
+
+func Test_NewPolyTaylorFunctions(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		f    func(float64) float64
+		p    Poly
+		a, h float64
+	}{
+		{name: "cos at 0", f: math.Cos, p: NewPolyTaylorCos(20, 0), a: 0, h: 2},
+		{name: "cos at 1", f: math.Cos, p: NewPolyTaylorCos(20, 1), a: 1, h: 2},
+		{name: "exp at 0", f: math.Exp, p: NewPolyTaylorExp(25, 0), a: 0, h: 2},
+		{name: "exp at -1", f: math.Exp, p: NewPolyTaylorExp(25, -1), a: -1, h: 2},
+		{name: "log at 1", f: math.Log, p: NewPolyTaylorLog(15, 1), a: 1, h: 0.1},
+		{name: "log at 3", f: math.Log, p: NewPolyTaylorLog(15, 3), a: 3, h: 0.3},
+		{name: "atan at 0", f: math.Atan, p: NewPolyTaylorAtan(60, 0), a: 0, h: 0.5},
+		{name: "atan at 2", f: math.Atan, p: NewPolyTaylorAtan(15, 2), a: 2, h: 0.25},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for x := tc.a - tc.h; x <= tc.a+tc.h; x += tc.h / 10 {
+				assert.InDelta(t, tc.f(x), tc.p.At(x), 1e-9)
+			}
+		})
+	}
+
+	assert.Equal(t, []float64{1, 0, -0.5}, NewPolyTaylorCos(3, 0).coef)
+	assert.Equal(t, []float64{1, 1, 0.5, 1.0 / 6}, NewPolyTaylorExp(3, 0).coef)
+	assert.Equal(t, []float64{0, 1, 0, -1.0 / 3}, NewPolyTaylorAtan(4, 0).coef)
+	assert.Equal(t, []float64{math.Log(2)}, NewPolyTaylorLog(0, 2).coef)
+}
+
+func Test_TaylorRemainderBoundPanic(t *testing.T) {
+
+	assert.Panics(t, func() { TaylorRemainderBound(-1, 0, -1, 1, 1) })
+	assert.Panics(t, func() { TaylorRemainderBound(1, 0, -1, 1, -1) })
+	assert.Panics(t, func() { TaylorRemainderBound(1, 0, 1, -1, 1) })
+}
+
+func Test_TaylorRemainderBound(t *testing.T) {
+
+	// |sin^(n + 1)| <= 1 everywhere.
+	for _, n := range []int{1, 3, 5, 9} {
+		bound := TaylorRemainderBound(n, 1, 0, 2.5, 1)
+		p := NewPolyTaylorSin(n, 1)
+
+		for x := 0.0; x <= 2.5; x += 0.05 {
+			assert.LessOrEqual(t, math.Abs(math.Sin(x)-p.At(x)), bound)
+		}
+	}
+
+	assert.InDelta(t, math.Exp(1)/24, TaylorRemainderBound(3, 0, -1, 1, math.Exp(1)), 1e-15)
+	assert.Equal(t, 0.0, TaylorRemainderBound(4, 2, 2, 2, 10))
+}
+
 func Test_NewPolyChebyshevPanic(t *testing.T) {
 
 	assert.Panics(t, func() { NewPolyChebyshev1(-1) })
@@ -713,9 +822,8 @@ func Test_NewPolyLegendre(t *testing.T) {
 		{
 			name: "n = 10",
 			arg:  10,
-			wantCoefs: []float64{-0.24609374999999997, 0, 13.535156249999998, 0,
-				-117.30468749999999, 0, 351.91406249999994, 0, -427.32421874999994, 0,
-				180.42578124999997},
+			wantCoefs: []float64{-63. / 256, 0, 3465. / 256, 0, -30030. / 256, 0, 90090. / 256, 0,
+				-109395. / 256, 0, 46189. / 256},
 			wantLen: 11,
 			wantDeg: 10,
 		},
@@ -798,6 +906,101 @@ func Test_NewPolyLaguerre(t *testing.T) {
 	}
 }
 
+func Test_NewPolyOrthogonalPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyLaguerreAssoc(-1, 0) })
+	assert.Panics(t, func() { NewPolyHermite(-1) })
+	assert.Panics(t, func() { NewPolyHermiteE(-1) })
+	assert.Panics(t, func() { NewPolyGegenbauer(-1, 1) })
+	assert.Panics(t, func() { NewPolyJacobi(-1, 0, 0) })
+	assert.Panics(t, func() { NewPolyJacobi(2, -1, 0) })
+	assert.Panics(t, func() { NewPolyJacobi(2, 0, -1.5) })
+}
+
+func Test_NewPolyOrthogonal(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		got       Poly
+		wantCoefs []float64
+	}{
+		{
+			name:      "Hermite n = 0",
+			got:       NewPolyHermite(0),
+			wantCoefs: []float64{1},
+		},
+		{
+			name:      "Hermite n = 3",
+			got:       NewPolyHermite(3),
+			wantCoefs: []float64{0, -12, 0, 8},
+		},
+		{
+			name:      "Hermite n = 6",
+			got:       NewPolyHermite(6),
+			wantCoefs: []float64{-120, 0, 720, 0, -480, 0, 64},
+		},
+		{
+			name:      "HermiteE n = 1",
+			got:       NewPolyHermiteE(1),
+			wantCoefs: []float64{0, 1},
+		},
+		{
+			name:      "HermiteE n = 5",
+			got:       NewPolyHermiteE(5),
+			wantCoefs: []float64{0, 15, 0, -10, 0, 1},
+		},
+		{
+			name:      "Laguerre alpha = 1, n = 2",
+			got:       NewPolyLaguerreAssoc(2, 1),
+			wantCoefs: []float64{3, -3, 0.5},
+		},
+		{
+			name:      "Laguerre alpha = 2, n = 3",
+			got:       NewPolyLaguerreAssoc(3, 2),
+			wantCoefs: []float64{10, -10, 2.5, -1. / 6},
+		},
+		{
+			name:      "Gegenbauer lambda = 2, n = 3",
+			got:       NewPolyGegenbauer(3, 2),
+			wantCoefs: []float64{0, -12, 0, 32},
+		},
+		{
+			name:      "Jacobi (1, 2), n = 1",
+			got:       NewPolyJacobi(1, 1, 2),
+			wantCoefs: []float64{-0.5, 2.5},
+		},
+		{
+			name:      "Jacobi (1, 1), n = 2",
+			got:       NewPolyJacobi(2, 1, 1),
+			wantCoefs: []float64{-0.75, 0, 3.75},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDeltaSlice(t, tc.wantCoefs, tc.got.coef, 1e-12)
+		})
+	}
+}
+
+func Test_NewPolyOrthogonalSpecialCases(t *testing.T) {
+
+	for n := 0; n <= 12; n++ {
+
+		// He_n(x) = 2^(-n/2)H_n(x / sqrt(2)).
+		h := NewPolyHermite(n).Compose(NewPolyLinear(1/math.Sqrt2, 0)).MulScalar(math.Pow(2, -float64(n)/2))
+		assert.InDeltaSlice(t, h.coef, NewPolyHermiteE(n).coef, 1e-9)
+
+		assert.InDeltaSlice(t, NewPolyLaguerre(n).coef, NewPolyLaguerreAssoc(n, 0).coef, 1e-12)
+		assert.InDeltaSlice(t, NewPolyChebyshev2(n).coef, NewPolyGegenbauer(n, 1).coef, 1e-9)
+		assert.InDeltaSlice(t, NewPolyLegendre(n).coef, NewPolyGegenbauer(n, 0.5).coef, 1e-9)
+		assert.InDeltaSlice(t, NewPolyLegendre(n).coef, NewPolyJacobi(n, 0, 0).coef, 1e-9)
+
+		// P_n^(alpha, beta)(1) = binomial(n + alpha, n).
+		assert.InDelta(t, choose(n+2, n), NewPolyJacobi(n, 2, 0.5).At(1), 1e-9)
+	}
+}
+
 func Test_PolyProperties(t *testing.T) {
 
 	// Test_Poly the property "getters".
@@ -1390,6 +1593,13 @@ func Test_PolyDiv(t *testing.T) {
 			wantQuo: NewPoly([]float64{1, -9, -27}),
 			wantRem: NewPoly([]float64{-123}),
 		},
+		{
+			name:    "div constant",
+			argP:    NewPoly([]float64{4, -2}),
+			argQ:    NewPoly([]float64{2}),
+			wantQuo: NewPoly([]float64{2, -1}),
+			wantRem: NewPolyZero(),
+		},
 		{
 			name:    "deg(q) > deg(p)",
 			argP:    NewPoly([]float64{1, -3}),
@@ -1541,3 +1751,13 @@ func Test_Poly_id(t *testing.T) {
 		})
 	}
 }
+
+func Test_Poly_exactId(t *testing.T) {
+
+	p := NewPoly([]float64{1, 0, 0})
+	q := NewPoly([]float64{1, 0, 1e-7})
+
+	assert.Equal(t, p.id(), q.id())
+	assert.NotEqual(t, p.exactId(), q.exactId())
+	assert.Equal(t, p.exactId(), NewPoly([]float64{1, 0, 0}).exactId())
+}
@@ -0,0 +1,165 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+// golub_welsch returns the nodes and weights of the len(alpha)-point Gauss quadrature rule for the
+// weight function whose monic orthogonal polynomials satisfy
+//
+// p_(k+1)(x) = (x - alpha[k])p_k(x) - beta[k - 1]^2 p_(k-1)(x),
+//
+// and whose integral is mu0. The nodes are the eigenvalues of the symmetric tridiagonal Jacobi
+// matrix with diagonal alpha and off-diagonal beta, and each weight is mu0 times the square of the
+// first component of the corresponding unit eigenvector.
+func golub_welsch(alpha, beta []float64, mu0 float64) ([]float64, []float64) {
+
+	nodes, first := symTridiagEigen(alpha, beta)
+
+	weights := make([]float64, len(nodes))
+	for i, v := range first {
+		weights[i] = mu0 * v * v
+	}
+
+	return nodes, weights
+}
+
+// symmetrize_rule restores the exact symmetry about 0 of the nodes and weights of a quadrature rule
+// for an even weight function, which rounding errors in golub_welsch() slightly break.
+func symmetrize_rule(nodes, weights []float64) ([]float64, []float64) {
+
+	for i, j := 0, len(nodes)-1; i <= j; i, j = i+1, j-1 {
+		x := (nodes[j] - nodes[i]) / 2
+		w := (weights[i] + weights[j]) / 2
+		nodes[i], nodes[j] = -x, x
+		weights[i], weights[j] = w, w
+	}
+
+	return nodes, weights
+}
+
+// GaussLegendre returns the nodes (in increasing order) and weights of the n-point Gauss-Legendre
+// quadrature rule, which integrates polynomials of degree at most 2n - 1 exactly over [-1, 1].
+//
+// The nodes are the roots of the nth Legendre polynomial (see NewPolyLegendre()).
+//
+// Panics for n < 1.
+func GaussLegendre(n int) ([]float64, []float64) {
+
+	if n < 1 {
+		log.Panic("GaussLegendre: n less than 1.")
+	}
+
+	alpha := make([]float64, n)
+	beta := make([]float64, n-1)
+	for k := 1; k < n; k++ {
+		fk := float64(k)
+		beta[k-1] = fk / math.Sqrt(4*fk*fk-1)
+	}
+
+	return symmetrize_rule(golub_welsch(alpha, beta, 2))
+}
+
+// GaussLaguerre returns the nodes (in increasing order) and weights of the n-point Gauss-Laguerre
+// quadrature rule, which integrates p(x)e^(-x) exactly over [0, inf) for polynomials p of degree at
+// most 2n - 1.
+//
+// The nodes are the roots of the nth Laguerre polynomial (see NewPolyLaguerre()).
+//
+// Panics for n < 1.
+func GaussLaguerre(n int) ([]float64, []float64) {
+
+	if n < 1 {
+		log.Panic("GaussLaguerre: n less than 1.")
+	}
+
+	alpha := make([]float64, n)
+	beta := make([]float64, n-1)
+	for k := 0; k < n; k++ {
+		alpha[k] = float64(2*k + 1)
+		if k > 0 {
+			beta[k-1] = float64(k)
+		}
+	}
+
+	return golub_welsch(alpha, beta, 1)
+}
+
+// GaussHermite returns the nodes (in increasing order) and weights of the n-point Gauss-Hermite
+// quadrature rule, which integrates p(x)e^(-x^2) exactly over (-inf, inf) for polynomials p of
+// degree at most 2n - 1.
+//
+// The nodes are the roots of the nth (physicists') Hermite polynomial (see NewPolyHermite()).
+//
+// Panics for n < 1.
+func GaussHermite(n int) ([]float64, []float64) {
+
+	if n < 1 {
+		log.Panic("GaussHermite: n less than 1.")
+	}
+
+	alpha := make([]float64, n)
+	beta := make([]float64, n-1)
+	for k := 1; k < n; k++ {
+		beta[k-1] = math.Sqrt(float64(k) / 2)
+	}
+
+	return symmetrize_rule(golub_welsch(alpha, beta, math.Sqrt(math.Pi)))
+}
+
+// GaussChebyshev returns the nodes (in increasing order) and weights of the n-point
+// Gauss-Chebyshev quadrature rule, which integrates p(x) / sqrt(1 - x^2) exactly over [-1, 1] for
+// polynomials p of degree at most 2n - 1.
+//
+// The nodes are the roots of the nth Chebyshev polynomial of the first kind, and all weights are
+// pi / n. Both are known in closed form.
+//
+// Panics for n < 1.
+func GaussChebyshev(n int) ([]float64, []float64) {
+
+	if n < 1 {
+		log.Panic("GaussChebyshev: n less than 1.")
+	}
+
+	nodes := make([]float64, n)
+	weights := make([]float64, n)
+
+	for i := 0; i < n; i++ {
+		nodes[i] = -math.Cos(float64(2*i+1) * math.Pi / float64(2*n))
+		weights[i] = math.Pi / float64(n)
+	}
+
+	// The middle node of an odd rule is exactly 0.
+	if n%2 == 1 {
+		nodes[n/2] = 0
+	}
+
+	return nodes, weights
+}
+
+// Integrate returns an approximation of the definite integral of f from a to b, using the n-point
+// Gauss-Legendre quadrature rule (see GaussLegendre()).
+//
+// The result is exact for polynomials of degree at most 2n - 1, and converges rapidly in n for
+// smooth f.
+//
+// Panics for n < 1.
+func Integrate(f func(float64) float64, a, b float64, n int) float64 {
+
+	if n < 1 {
+		log.Panic("Integrate: n less than 1.")
+	}
+
+	nodes, weights := GaussLegendre(n)
+
+	// Map [-1, 1] onto [a, b].
+	half, mid := (b-a)/2, (a+b)/2
+
+	sum := 0.0
+	for i, x := range nodes {
+		sum += weights[i] * f(half*x+mid)
+	}
+
+	return half * sum
+}
@@ -0,0 +1,145 @@
+package polygo
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in quadrature.go.
+*/
+
+func Test_GaussPanic(t *testing.T) {
+
+	assert.Panics(t, func() { GaussLegendre(0) })
+	assert.Panics(t, func() { GaussLaguerre(0) })
+	assert.Panics(t, func() { GaussHermite(-1) })
+	assert.Panics(t, func() { GaussChebyshev(0) })
+	assert.Panics(t, func() { Integrate(math.Sin, 0, 1, 0) })
+}
+
+func Test_GaussLegendre(t *testing.T) {
+
+	x, w := GaussLegendre(1)
+	assert.Equal(t, []float64{0}, x)
+	assert.InDeltaSlice(t, []float64{2}, w, 1e-15)
+
+	x, w = GaussLegendre(3)
+	assert.InDeltaSlice(t, []float64{-math.Sqrt(0.6), 0, math.Sqrt(0.6)}, x, 1e-15)
+	assert.InDeltaSlice(t, []float64{5. / 9, 8. / 9, 5. / 9}, w, 1e-15)
+
+	// Nodes are the roots of the Legendre polynomial, and the rule is exact up to degree 2n - 1.
+	for _, n := range []int{2, 5, 10, 20} {
+		x, w := GaussLegendre(n)
+
+		assert.True(t, sort.Float64sAreSorted(x))
+		for _, v := range x {
+			assert.InDelta(t, 0, NewPolyLegendre(n).At(v), 1e-9)
+		}
+
+		for k := 0; k < 2*n; k++ {
+			got := 0.0
+			for i := range x {
+				got += w[i] * math.Pow(x[i], float64(k))
+			}
+
+			want := 0.0
+			if k%2 == 0 {
+				want = 2 / float64(k+1)
+			}
+
+			assert.InDelta(t, want, got, 1e-13)
+		}
+	}
+}
+
+func Test_GaussLaguerre(t *testing.T) {
+
+	x, w := GaussLaguerre(2)
+	assert.InDeltaSlice(t, []float64{2 - math.Sqrt2, 2 + math.Sqrt2}, x, 1e-14)
+	assert.InDeltaSlice(t, []float64{(2 + math.Sqrt2) / 4, (2 - math.Sqrt2) / 4}, w, 1e-14)
+
+	// Integral of x^k e^(-x) over [0, inf) is k!.
+	for _, n := range []int{1, 4, 8} {
+		x, w := GaussLaguerre(n)
+
+		for _, v := range x {
+			assert.InDelta(t, 0, NewPolyLaguerre(n).At(v), 1e-9)
+		}
+
+		for k := 0; k < 2*n; k++ {
+			got := 0.0
+			for i := range x {
+				got += w[i] * math.Pow(x[i], float64(k))
+			}
+
+			assert.InEpsilon(t, fact(k), got, 1e-12)
+		}
+	}
+}
+
+func Test_GaussHermite(t *testing.T) {
+
+	x, w := GaussHermite(2)
+	assert.InDeltaSlice(t, []float64{-1 / math.Sqrt2, 1 / math.Sqrt2}, x, 1e-15)
+	assert.InDeltaSlice(t, []float64{math.Sqrt(math.Pi) / 2, math.Sqrt(math.Pi) / 2}, w, 1e-15)
+
+	// Integral of x^(2k) e^(-x^2) over the real line is (2k - 1)!! sqrt(pi) / 2^k.
+	for _, n := range []int{1, 3, 6, 10} {
+		x, w := GaussHermite(n)
+
+		for _, v := range x {
+			assert.InDelta(t, 0, NewPolyHermite(n).At(v)/math.Pow(2, float64(n)), 1e-6)
+		}
+
+		want := math.Sqrt(math.Pi)
+		for k := 0; 2*k < 2*n; k++ {
+			got := 0.0
+			for i := range x {
+				got += w[i] * math.Pow(x[i], float64(2*k))
+			}
+
+			assert.InEpsilon(t, want, got, 1e-12)
+			want *= float64(2*k+1) / 2
+		}
+	}
+}
+
+func Test_GaussChebyshev(t *testing.T) {
+
+	x, w := GaussChebyshev(3)
+	assert.InDeltaSlice(t, []float64{-math.Sqrt(3) / 2, 0, math.Sqrt(3) / 2}, x, 1e-15)
+	assert.InDeltaSlice(t, []float64{math.Pi / 3, math.Pi / 3, math.Pi / 3}, w, 1e-15)
+
+	// Integral of x^2 / sqrt(1 - x^2) over [-1, 1] is pi / 2.
+	x, w = GaussChebyshev(5)
+	got := 0.0
+	for i := range x {
+		got += w[i] * x[i] * x[i]
+	}
+	assert.InDelta(t, math.Pi/2, got, 1e-14)
+}
+
+func Test_Integrate(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		n    int
+		want float64
+	}{
+		{name: "cubic", f: NewPoly([]float64{4, -3, 2, 1}).At, a: 0, b: 2, n: 2, want: 16 - 8 + 4 + 2},
+		{name: "sin", f: math.Sin, a: 0, b: math.Pi, n: 10, want: 2},
+		{name: "exp", f: math.Exp, a: -1, b: 2, n: 12, want: math.Exp(2) - math.Exp(-1)},
+		{name: "reversed", f: math.Exp, a: 2, b: -1, n: 12, want: math.Exp(-1) - math.Exp(2)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, Integrate(tc.f, tc.a, tc.b, tc.n), 1e-13)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package polygo
+
+import (
+	"log"
+	"math"
+	"math/big"
+)
+
+// A RatPoly represents a univariate polynomial with exact rational coefficients.
+//
+// All arithmetic on RatPoly is exact, so results such as Sturm root counts are certified for
+// integer and rational inputs.
+//
+// Note: in the documentation for each method of RatPoly, we refer to the receiver instance as "p".
+type RatPoly struct {
+	coef []*big.Rat
+	len  int
+	deg  int
+}
+
+// NewRatPoly returns a rational polynomial p with the given coefficients.
+//
+// Let c = coefficients and let n = len(c). Then, p is defined by
+//
+//   - p(x) = c[0]x^(n-1) + c[1]x^(n-2) + ... + c[n-2]x^1 + c[n-1]x^0.
+//
+// The coefficients are copied, so the caller may modify them afterwards.
+//
+// Panics if coefficients slice is empty or contains nil.
+func NewRatPoly(coefficients []*big.Rat) RatPoly {
+
+	if len(coefficients) == 0 {
+		log.Panic("NewRatPoly: empty coefficients slice.")
+	}
+
+	n := len(coefficients)
+	coef := make([]*big.Rat, n)
+
+	for i, c := range coefficients {
+		if c == nil {
+			log.Panic("NewRatPoly: nil coefficient.")
+		}
+		coef[n-i-1] = new(big.Rat).Set(c)
+	}
+
+	return newRatPolyNoReverse(coef)
+}
+
+// newRatPolyNoReverse is just NewRatPoly but with no coefficient slice reversal or copying.
+func newRatPolyNoReverse(coefficients []*big.Rat) RatPoly {
+
+	for len(coefficients) > 1 && coefficients[len(coefficients)-1].Sign() == 0 {
+		coefficients = coefficients[:len(coefficients)-1]
+	}
+
+	coefLen := len(coefficients)
+
+	return RatPoly{
+		coef: coefficients,
+		len:  coefLen,
+		deg:  coefLen - 1,
+	}
+}
+
+// NewRatPolyConst returns the rational polynomial p(x) = a.
+func NewRatPolyConst(a *big.Rat) RatPoly {
+
+	return newRatPolyNoReverse([]*big.Rat{new(big.Rat).Set(a)})
+}
+
+// NewRatPolyZero returns the rational polynomial p(x) = 0.
+func NewRatPolyZero() RatPoly {
+
+	return newRatPolyNoReverse([]*big.Rat{new(big.Rat)})
+}
+
+// NewRatPolyFromPoly returns p as a rational polynomial.
+//
+// The conversion is lossless since every finite float64 is a rational number.
+//
+// Panics if p has an infinite or NaN coefficient.
+func NewRatPolyFromPoly(p Poly) RatPoly {
+
+	coef := make([]*big.Rat, p.len)
+
+	for i, c := range p.coef {
+		if math.IsInf(c, 0) || math.IsNaN(c) {
+			log.Panicf("NewRatPolyFromPoly: non-finite coefficient %f.", c)
+		}
+		coef[i] = new(big.Rat).SetFloat64(c)
+	}
+
+	return newRatPolyNoReverse(coef)
+}
+
+// ToPoly returns p as a Poly, with each coefficient rounded to the nearest float64.
+func (p RatPoly) ToPoly() Poly {
+
+	coef := make([]float64, p.len)
+
+	for i, c := range p.coef {
+		coef[i], _ = c.Float64()
+	}
+
+	return newPolyNoReverse(coef)
+}
+
+// Coefficients returns copies of the coefficients c of p ordered in decreasing degree.
+func (p RatPoly) Coefficients() []*big.Rat {
+
+	ret := make([]*big.Rat, p.len)
+	for i, c := range p.coef {
+		ret[p.len-i-1] = new(big.Rat).Set(c)
+	}
+
+	return ret
+}
+
+// Degree returns the degree of p.
+func (p RatPoly) Degree() int {
+
+	return p.deg
+}
+
+// LeadingCoefficient returns a copy of the coefficient of the highest-degreed term in p.
+func (p RatPoly) LeadingCoefficient() *big.Rat {
+
+	return new(big.Rat).Set(p.coef[p.deg])
+}
+
+// Equal returns true if the p is equal to q (all corresponding coefficients are equal), else false.
+func (p RatPoly) Equal(q RatPoly) bool {
+
+	if p.deg != q.deg {
+		return false
+	}
+
+	for i := 0; i < p.len; i++ {
+		if p.coef[i].Cmp(q.coef[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsConstant returns true p is constant (i.e. deg(p) = 0), else false.
+func (p RatPoly) IsConstant() bool {
+
+	return p.deg == 0
+}
+
+// IsZero returns true if p(x) = 0, else false.
+func (p RatPoly) IsZero() bool {
+
+	return p.deg == 0 && p.coef[0].Sign() == 0
+}
+
+// At returns the value of p evaluated at x.
+func (p RatPoly) At(x *big.Rat) *big.Rat {
+
+	return p.Generic().At(Rational{x}).Rat()
+}
+
+// Add returns the polynomial sum p + q.
+func (p RatPoly) Add(q RatPoly) RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().Add(q.Generic()))
+}
+
+// Sub returns the polynomial difference p - q.
+func (p RatPoly) Sub(q RatPoly) RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().Sub(q.Generic()))
+}
+
+// MulScalar returns the scalar-polynomial product sp.
+func (p RatPoly) MulScalar(s *big.Rat) RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().MulScalar(Rational{s}))
+}
+
+// Mul returns the polynomial product pq.
+func (p RatPoly) Mul(q RatPoly) RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().Mul(q.Generic()))
+}
+
+// Pow returns the polynomial power p^n.
+//
+// Panics for negative n.
+func (p RatPoly) Pow(n int) RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().Pow(n))
+}
+
+// Div returns m (polynomial quotient) and n (polynomial remainder) such that p/q = m + n/q.
+//
+// Panics if q = 0.
+func (p RatPoly) Div(q RatPoly) (RatPoly, RatPoly) {
+
+	if q.IsZero() {
+		log.Panic("Div: division by zero polynomial.")
+	}
+
+	m, n := GenericDiv(p.Generic(), q.Generic())
+
+	return NewRatPolyFromGeneric(m), NewRatPolyFromGeneric(n)
+}
+
+// Monic returns a monic polynomial by dividing each coefficient in p by the lead coefficient.
+//
+// Panics if p = 0.
+func (p RatPoly) Monic() RatPoly {
+
+	if p.IsZero() {
+		log.Panic("Monic: zero polynomial.")
+	}
+
+	return p.MulScalar(new(big.Rat).Inv(p.coef[p.deg]))
+}
+
+// Derivative returns the derivative of p.
+func (p RatPoly) Derivative() RatPoly {
+
+	return NewRatPolyFromGeneric(p.Generic().Derivative())
+}
+
+// GCD returns the monic greatest common divisor of p and q.
+//
+// If both p and q are zero, the zero polynomial is returned.
+func (p RatPoly) GCD(q RatPoly) RatPoly {
+
+	for !q.IsZero() {
+		_, r := p.Div(q)
+		p, q = q, r
+	}
+
+	if p.IsZero() {
+		return p
+	}
+
+	return p.Monic()
+}
+
+// ExtendedGCD returns the monic greatest common divisor g of p and q together with the Bezout
+// cofactors s and t such that sp + tq = g.
+//
+// If both p and q are zero, all three returned polynomials are zero.
+func (p RatPoly) ExtendedGCD(q RatPoly) (RatPoly, RatPoly, RatPoly) {
+
+	one := big.NewRat(1, 1)
+
+	r0, r1 := p, q
+	s0, s1 := NewRatPolyConst(one), NewRatPolyZero()
+	t0, t1 := NewRatPolyZero(), NewRatPolyConst(one)
+
+	for !r1.IsZero() {
+		quo, rem := r0.Div(r1)
+
+		r0, r1 = r1, rem
+		s0, s1 = s1, s0.Sub(quo.Mul(s1))
+		t0, t1 = t1, t0.Sub(quo.Mul(t1))
+	}
+
+	if r0.IsZero() {
+		return NewRatPolyZero(), NewRatPolyZero(), NewRatPolyZero()
+	}
+
+	lead := new(big.Rat).Inv(r0.coef[r0.deg])
+
+	return r0.MulScalar(lead), s0.MulScalar(lead), t0.MulScalar(lead)
+}
+
+// LCM returns the monic least common multiple of p and q.
+//
+// If either p or q is zero, the zero polynomial is returned.
+func (p RatPoly) LCM(q RatPoly) RatPoly {
+
+	if p.IsZero() || q.IsZero() {
+		return NewRatPolyZero()
+	}
+
+	quo, _ := p.Div(p.GCD(q))
+
+	return quo.Mul(q).Monic()
+}
+
+// CountSturm returns the number of distinct real roots of p on the interval (a, b].
+//
+// Since the Sturm chain is computed exactly, the count is certified.
+func (p RatPoly) CountSturm(a, b *big.Rat) int {
+
+	return new_ratSturmChain(p).count(a, b)
+}
+
+// String returns a string representation of p in decreasing-degree sum form with exact
+// coefficients.
+func (p RatPoly) String() string {
+
+	return p.Generic().String()
+}
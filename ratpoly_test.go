@@ -0,0 +1,185 @@
+package polygo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in ratpoly.go.
+*/
+
+// rats returns the integers in s as a slice of *big.Rat.
+func rats(s ...int64) []*big.Rat {
+	ret := make([]*big.Rat, len(s))
+	for i, v := range s {
+		ret[i] = big.NewRat(v, 1)
+	}
+	return ret
+}
+
+func Test_NewRatPolyPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewRatPoly([]*big.Rat{}) })
+	assert.Panics(t, func() { NewRatPoly([]*big.Rat{nil}) })
+}
+
+func Test_NewRatPoly(t *testing.T) {
+
+	p := NewRatPoly(rats(0, 0, 3, -1, 4))
+
+	assert.Equal(t, 2, p.Degree())
+	assert.Equal(t, "[ 3x^{2} - 1x^{1} + 4x^{0} ]", p.String())
+	assert.True(t, NewRatPoly(rats(0, 0)).IsZero())
+}
+
+func Test_RatPolyConversions(t *testing.T) {
+
+	p := NewPoly([]float64{0.1, -2.5, 3})
+	r := NewRatPolyFromPoly(p)
+
+	// 0.1 is not exactly representable, so the rational is its exact binary value.
+	assert.Equal(t, new(big.Rat).SetFloat64(0.1), r.LeadingCoefficient())
+	assert.Equal(t, p, r.ToPoly())
+}
+
+func Test_RatPolyAt(t *testing.T) {
+
+	p := NewRatPoly(rats(3, -1, 4))
+
+	assert.Equal(t, big.NewRat(4, 1), p.At(big.NewRat(0, 1)))
+	assert.Equal(t, big.NewRat(17, 4), p.At(big.NewRat(1, 2)))
+}
+
+func Test_RatPolyArithmetic(t *testing.T) {
+
+	p := NewRatPoly(rats(1, 1))
+	q := NewRatPoly(rats(1, -1))
+
+	assert.True(t, p.Add(q).Equal(NewRatPoly(rats(2, 0))))
+	assert.True(t, p.Sub(q).Equal(NewRatPoly(rats(2))))
+	assert.True(t, p.Sub(p).IsZero())
+	assert.True(t, p.Mul(q).Equal(NewRatPoly(rats(1, 0, -1))))
+	assert.True(t, p.Pow(3).Equal(NewRatPoly(rats(1, 3, 3, 1))))
+	assert.True(t, p.MulScalar(big.NewRat(1, 3)).Equal(NewRatPoly([]*big.Rat{big.NewRat(1, 3), big.NewRat(1, 3)})))
+}
+
+func Test_RatPolyDiv(t *testing.T) {
+
+	assert.Panics(t, func() { NewRatPoly(rats(1)).Div(NewRatPolyZero()) })
+
+	// (2x^3 - 3x + 1) / (2x^2 + 1) = x + (-4x + 1)/(2x^2 + 1).
+	m, n := NewRatPoly(rats(2, 0, -3, 1)).Div(NewRatPoly(rats(2, 0, 1)))
+	assert.True(t, m.Equal(NewRatPoly(rats(1, 0))))
+	assert.True(t, n.Equal(NewRatPoly(rats(-4, 1))))
+
+	// Exact division by a constant.
+	m, n = NewRatPoly(rats(1, 2)).Div(NewRatPoly(rats(3)))
+	assert.True(t, m.Equal(NewRatPoly([]*big.Rat{big.NewRat(1, 3), big.NewRat(2, 3)})))
+	assert.True(t, n.IsZero())
+}
+
+func Test_RatPolyDerivative(t *testing.T) {
+
+	assert.True(t, NewRatPoly(rats(7)).Derivative().IsZero())
+	assert.True(t, NewRatPoly(rats(1, 2, 3, 4)).Derivative().Equal(NewRatPoly(rats(3, 4, 3))))
+}
+
+func Test_RatPolyGCD(t *testing.T) {
+
+	// (x - 1)^2 (x + 2) and (x - 1)(x + 3) share the factor x - 1.
+	p := NewRatPolyFromPoly(NewPolyFactored(1, []float64{1, 1, -2}))
+	q := NewRatPolyFromPoly(NewPolyFactored(5, []float64{1, -3}))
+
+	assert.True(t, p.GCD(q).Equal(NewRatPoly(rats(1, -1))))
+	assert.True(t, p.GCD(NewRatPolyZero()).Equal(p.Monic()))
+	assert.True(t, NewRatPolyZero().GCD(NewRatPolyZero()).IsZero())
+}
+
+func Test_RatPolyCountSturm(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		argP RatPoly
+		argA *big.Rat
+		argB *big.Rat
+		want int
+	}{
+		{
+			name: "constant",
+			argP: NewRatPoly(rats(5)),
+			argA: big.NewRat(-1, 1),
+			argB: big.NewRat(1, 1),
+			want: 0,
+		},
+		{
+			name: "integer roots 1 to 10",
+			argP: NewRatPolyFromPoly(NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})),
+			argA: big.NewRat(0, 1),
+			argB: big.NewRat(11, 1),
+			want: 10,
+		},
+		{
+			name: "right endpoint is a root",
+			argP: NewRatPoly(rats(1, 0, -1)),
+			argA: big.NewRat(0, 1),
+			argB: big.NewRat(1, 1),
+			want: 1,
+		},
+		{
+			name: "left endpoint is a root",
+			argP: NewRatPoly(rats(1, 0, -1)),
+			argA: big.NewRat(-1, 1),
+			argB: big.NewRat(0, 1),
+			want: 0,
+		},
+		{
+			name: "repeated roots at endpoints",
+			argP: NewRatPolyFromPoly(NewPolyFactored(1, []float64{1, 1, 1, 2, 2, 3})),
+			argA: big.NewRat(1, 1),
+			argB: big.NewRat(2, 1),
+			want: 1,
+		},
+		{
+			name: "clustered rational roots",
+			argP: NewRatPoly(rats(10000, -20000, 9999)), // roots 1 +- 1/100
+			argA: big.NewRat(98, 100),
+			argB: big.NewRat(102, 100),
+			want: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.argP.CountSturm(tc.argA, tc.argB)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_SolverCountRootsWithinExact(t *testing.T) {
+
+	s := NewSolver(ALG_COUNT_STURM_EXACT, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+	p := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	assert.Equal(t, 10, s.CountRootsWithin(p, 0, 11))
+	assert.Equal(t, 5, s.CountRootsWithin(p, 0.5, 5))
+
+	roots := s.FindRootsWithin(p, 0, 11)
+	assert.Len(t, roots, 10)
+	for i, x := range roots {
+		assert.InDelta(t, float64(i+1), x, 1e-5)
+	}
+}
+
+func Test_SolverCountRootsWithinExactCache(t *testing.T) {
+
+	// x^2 and x^2 + 1e-7 print alike to 6 decimal places, so must not share a cached chain.
+	s := NewSolver(ALG_COUNT_STURM_EXACT, ALG_ISOLATE_BISECT, ALG_SEARCH_BISECT)
+
+	assert.Equal(t, 1, s.CountRootsWithin(NewPoly([]float64{1, 0, 0}), -1, 1))
+	assert.Equal(t, 0, s.CountRootsWithin(NewPoly([]float64{1, 0, 1e-7}), -1, 1))
+}
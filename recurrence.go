@@ -0,0 +1,362 @@
+package polygo
+
+import (
+	"log"
+	"math"
+)
+
+// A Recurrence represents a sequence of polynomials P_0, P_1, P_2, ... defined by the three-term
+// recurrence
+//
+// d(n)P_(n+1)(x) = (a(n)x + b(n))P_n(x) - c(n)P_(n-1)(x),
+//
+// for n >= 0, with a given P_0 and P_(-1) = 0. When d is not given, d(n) = 1.
+//
+// Every classical family of orthogonal polynomials satisfies such a recurrence. Each P_n is built
+// from the two before it, so generating P_0, ..., P_n takes O(n^2) time.
+//
+// If a, b, c and d take integer values and P_0 has integer coefficients, the polynomials are
+// generated exactly with a single rounding at the end, by running the recurrence on
+// d(0)d(1)...d(n - 1)P_n instead. Once the integers involved would exceed 2^53, and so no longer
+// be exact in a float64, the recurrence continues on P_n itself, dividing by d(n) at each step.
+type Recurrence struct {
+	p0 Poly
+	a  func(n int) float64
+	b  func(n int) float64
+	c  func(n int) float64
+	d  func(n int) float64
+}
+
+// NewRecurrence returns the Recurrence with initial polynomial p0 and coefficient functions a, b,
+// c and d (see Recurrence). d may be nil, in which case d(n) = 1.
+//
+// Panics if any of a, b or c is nil.
+func NewRecurrence(p0 Poly, a, b, c, d func(n int) float64) Recurrence {
+
+	if a == nil || b == nil || c == nil {
+		log.Panic("NewRecurrence: nil coefficient function.")
+	}
+
+	if d == nil {
+		d = constantFunc(1)
+	}
+
+	return Recurrence{p0: p0, a: a, b: b, c: c, d: d}
+}
+
+// A recurrenceState holds the scaled polynomials Q_n = S_nP_n and Q_(n-1) = S_(n-1)P_(n-1), where
+// S_n = d(0)d(1)...d(n - 1). Once scaled is false, S_n = S_(n-1) = 1.
+type recurrenceState struct {
+	r         Recurrence
+	n         int
+	cur       Poly
+	prev      Poly
+	scale     float64
+	prevScale float64
+	scaled    bool
+}
+
+// maxExactInt is the largest integer below which every integer is exact in a float64.
+const maxExactInt = 1 << 53
+
+// isExactInt returns true if every value in s is an integer of magnitude below maxExactInt.
+func isExactInt(s ...float64) bool {
+
+	for _, v := range s {
+		if v != math.Trunc(v) || math.Abs(v) >= maxExactInt {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newRecurrenceState returns the state at n = 0.
+func (r Recurrence) newRecurrenceState() *recurrenceState {
+
+	return &recurrenceState{
+		r:         r,
+		n:         0,
+		cur:       r.p0,
+		prev:      NewPolyZero(),
+		scale:     1,
+		prevScale: 1,
+		scaled:    isExactInt(r.p0.coef...),
+	}
+}
+
+// divScalar returns p with every coefficient divided by s.
+func divScalar(p Poly, s float64) Poly {
+
+	if s == 1 {
+		return p
+	}
+
+	coef := make([]float64, p.len)
+	for i, c := range p.coef {
+		coef[i] = c / s
+	}
+
+	return newPolyNoReverse(coef)
+}
+
+// poly returns P_n = Q_n / S_n.
+func (rs *recurrenceState) poly() Poly {
+
+	return divScalar(rs.cur, rs.scale)
+}
+
+// step advances the state from n to n + 1.
+func (rs *recurrenceState) step() {
+
+	n := rs.n
+	a, b, c, d := rs.r.a(n), rs.r.b(n), rs.r.c(n), rs.r.d(n)
+
+	if n == 0 {
+		c = 0
+	}
+
+	if rs.scaled {
+		// Multiplying the recurrence through by S_n gives
+		// Q_(n+1) = (a(n)x + b(n))Q_n - c(n)d(n - 1)Q_(n-1).
+		cd := 0.0
+		if n > 0 {
+			cd = c * rs.r.d(n-1)
+		}
+
+		// Every intermediate value is an integer bounded by this, so is exact if it is.
+		bound := (math.Abs(a)+math.Abs(b))*maxAbs(rs.cur.coef) + math.Abs(cd)*maxAbs(rs.prev.coef)
+
+		if isExactInt(a, b, c, d, cd, bound, rs.scale*d) {
+			next := NewPolyLinear(a, b).Mul(rs.cur)
+
+			if cd != 0 {
+				next = next.Sub(rs.prev.MulScalar(cd))
+			}
+
+			rs.prevScale, rs.scale = rs.scale, rs.scale*d
+			rs.prev, rs.cur = rs.cur, next
+			rs.n++
+
+			return
+		}
+
+		// Continue on P_n and P_(n-1) themselves.
+		rs.prev, rs.cur = divScalar(rs.prev, rs.prevScale), divScalar(rs.cur, rs.scale)
+		rs.prevScale, rs.scale = 1, 1
+		rs.scaled = false
+	}
+
+	next := NewPolyLinear(a, b).Mul(rs.cur)
+
+	if c != 0 {
+		next = next.Sub(rs.prev.MulScalar(c))
+	}
+
+	rs.prev, rs.cur = rs.cur, divScalar(next, d)
+	rs.n++
+}
+
+// Sequence returns P_0, P_1, ..., P_n.
+//
+// Panics for negative n.
+func (r Recurrence) Sequence(n int) []Poly {
+
+	if n < 0 {
+		log.Panic("Sequence: negative n.")
+	}
+
+	seq := make([]Poly, n+1)
+
+	rs := r.newRecurrenceState()
+	for k := 0; k <= n; k++ {
+		if k > 0 {
+			rs.step()
+		}
+		seq[k] = rs.poly()
+	}
+
+	return seq
+}
+
+// Nth returns P_n.
+//
+// Panics for negative n.
+func (r Recurrence) Nth(n int) Poly {
+
+	if n < 0 {
+		log.Panic("Nth: negative n.")
+	}
+
+	rs := r.newRecurrenceState()
+	for k := 0; k < n; k++ {
+		rs.step()
+	}
+
+	return rs.poly()
+}
+
+// Iterator returns a function that returns P_0, P_1, P_2, ... on successive calls.
+func (r Recurrence) Iterator() func() Poly {
+
+	var rs *recurrenceState
+
+	return func() Poly {
+		if rs == nil {
+			rs = r.newRecurrenceState()
+		} else {
+			rs.step()
+		}
+
+		return rs.poly()
+	}
+}
+
+// At returns P_n(x).
+//
+// The recurrence is run on values rather than polynomials, which takes O(n) time and avoids
+// forming the (possibly badly conditioned) coefficients of P_n.
+//
+// Panics for negative n.
+func (r Recurrence) At(n int, x float64) float64 {
+
+	if n < 0 {
+		log.Panic("At: negative n.")
+	}
+
+	prev, cur := 0.0, r.p0.At(x)
+	for k := 0; k < n; k++ {
+		prev, cur = cur, ((r.a(k)*x+r.b(k))*cur-r.c(k)*prev)/r.d(k)
+	}
+
+	return cur
+}
+
+// constantFunc returns the coefficient function with value v for every n.
+func constantFunc(v float64) func(n int) float64 {
+
+	return func(int) float64 { return v }
+}
+
+// NewRecurrenceChebyshev1 returns the Recurrence for the Chebyshev polynomials of the first kind,
+//
+// T_0 = 1, T_1 = x and T_(n+1) = 2xT_n - T_(n-1).
+func NewRecurrenceChebyshev1() Recurrence {
+
+	a := func(n int) float64 {
+		if n == 0 {
+			return 1
+		}
+		return 2
+	}
+
+	return NewRecurrence(NewPolyConst(1), a, constantFunc(0), constantFunc(1), nil)
+}
+
+// NewRecurrenceChebyshev2 returns the Recurrence for the Chebyshev polynomials of the second kind,
+//
+// U_0 = 1 and U_(n+1) = 2xU_n - U_(n-1).
+func NewRecurrenceChebyshev2() Recurrence {
+
+	return NewRecurrence(NewPolyConst(1), constantFunc(2), constantFunc(0), constantFunc(1), nil)
+}
+
+// NewRecurrenceLegendre returns the Recurrence for the Legendre polynomials,
+//
+// P_0 = 1 and (n + 1)P_(n+1) = (2n + 1)xP_n - nP_(n-1).
+func NewRecurrenceLegendre() Recurrence {
+
+	a := func(n int) float64 { return float64(2*n + 1) }
+	c := func(n int) float64 { return float64(n) }
+	d := func(n int) float64 { return float64(n + 1) }
+
+	return NewRecurrence(NewPolyConst(1), a, constantFunc(0), c, d)
+}
+
+// NewRecurrenceLaguerreAssoc returns the Recurrence for the associated Laguerre polynomials,
+//
+// L_0 = 1 and (n + 1)L_(n+1) = (2n + 1 + alpha - x)L_n - (n + alpha)L_(n-1).
+func NewRecurrenceLaguerreAssoc(alpha float64) Recurrence {
+
+	b := func(n int) float64 { return float64(2*n+1) + alpha }
+	c := func(n int) float64 { return float64(n) + alpha }
+	d := func(n int) float64 { return float64(n + 1) }
+
+	return NewRecurrence(NewPolyConst(1), constantFunc(-1), b, c, d)
+}
+
+// NewRecurrenceHermite returns the Recurrence for the (physicists') Hermite polynomials,
+//
+// H_0 = 1 and H_(n+1) = 2xH_n - 2nH_(n-1).
+func NewRecurrenceHermite() Recurrence {
+
+	c := func(n int) float64 { return float64(2 * n) }
+
+	return NewRecurrence(NewPolyConst(1), constantFunc(2), constantFunc(0), c, nil)
+}
+
+// NewRecurrenceHermiteE returns the Recurrence for the probabilists' Hermite polynomials,
+//
+// He_0 = 1 and He_(n+1) = xHe_n - nHe_(n-1).
+func NewRecurrenceHermiteE() Recurrence {
+
+	c := func(n int) float64 { return float64(n) }
+
+	return NewRecurrence(NewPolyConst(1), constantFunc(1), constantFunc(0), c, nil)
+}
+
+// NewRecurrenceGegenbauer returns the Recurrence for the Gegenbauer polynomials,
+//
+// C_0 = 1 and (n + 1)C_(n+1) = 2(n + lambda)xC_n - (n + 2lambda - 1)C_(n-1).
+func NewRecurrenceGegenbauer(lambda float64) Recurrence {
+
+	a := func(n int) float64 { return 2 * (float64(n) + lambda) }
+	c := func(n int) float64 { return float64(n) + 2*lambda - 1 }
+	d := func(n int) float64 { return float64(n + 1) }
+
+	return NewRecurrence(NewPolyConst(1), a, constantFunc(0), c, d)
+}
+
+// NewRecurrenceJacobi returns the Recurrence for the Jacobi polynomials P_n^(alpha, beta),
+//
+// P_0 = 1, P_1 = (alpha + 1) + (alpha + beta + 2)(x - 1) / 2 and, with c = 2n + alpha + beta,
+//
+// 2(n + 1)(n + alpha + beta + 1)cP_(n+1)
+// = (c + 1)((c + 2)cx + alpha^2 - beta^2)P_n - 2(n + alpha)(n + beta)(c + 2)P_(n-1).
+//
+// Panics for alpha <= -1 or beta <= -1.
+func NewRecurrenceJacobi(alpha, beta float64) Recurrence {
+
+	if alpha <= -1 || beta <= -1 {
+		log.Panicf("NewRecurrenceJacobi: parameters (%f, %f) not greater than -1.", alpha, beta)
+	}
+
+	ab := alpha + beta
+
+	// The general formula divides by zero at n = 0 when alpha + beta = 0 or -1.
+	a := func(n int) float64 {
+		if n == 0 {
+			return (ab + 2) / 2
+		}
+		fn, c := float64(n), 2*float64(n)+ab
+		return (c + 1) * (c + 2) / (2 * (fn + 1) * (fn + ab + 1))
+	}
+
+	b := func(n int) float64 {
+		if n == 0 {
+			return (alpha - beta) / 2
+		}
+		fn, c := float64(n), 2*float64(n)+ab
+		return (c + 1) * (alpha*alpha - beta*beta) / (2 * (fn + 1) * (fn + ab + 1) * c)
+	}
+
+	c := func(n int) float64 {
+		if n == 0 {
+			return 0
+		}
+		fn, c := float64(n), 2*float64(n)+ab
+		return (fn + alpha) * (fn + beta) * (c + 2) / ((fn + 1) * (fn + ab + 1) * c)
+	}
+
+	return NewRecurrence(NewPolyConst(1), a, b, c, nil)
+}
@@ -0,0 +1,137 @@
+package polygo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in recurrence.go.
+*/
+
+func Test_RecurrencePanic(t *testing.T) {
+
+	one := constantFunc(1)
+	r := NewRecurrence(NewPolyConst(1), one, one, one, nil)
+
+	assert.Panics(t, func() { NewRecurrence(NewPolyConst(1), nil, one, one, one) })
+	assert.Panics(t, func() { NewRecurrence(NewPolyConst(1), one, nil, one, one) })
+	assert.Panics(t, func() { NewRecurrence(NewPolyConst(1), one, one, nil, one) })
+	assert.Panics(t, func() { r.Sequence(-1) })
+	assert.Panics(t, func() { r.Nth(-1) })
+	assert.Panics(t, func() { r.At(-1, 0) })
+	assert.Panics(t, func() { NewRecurrenceJacobi(-1, 0) })
+}
+
+func Test_Recurrence(t *testing.T) {
+
+	// Fibonacci polynomials: F_0 = 1, F_(n+1) = xF_n + F_(n-1).
+	fib := NewRecurrence(NewPolyConst(1), constantFunc(1), constantFunc(0), constantFunc(-1), nil)
+
+	want := [][]float64{{1}, {0, 1}, {1, 0, 1}, {0, 2, 0, 1}, {1, 0, 3, 0, 1}}
+
+	seq := fib.Sequence(4)
+	assert.Len(t, seq, 5)
+
+	next := fib.Iterator()
+
+	for n, w := range want {
+		assert.Equal(t, w, seq[n].coef)
+		assert.Equal(t, w, fib.Nth(n).coef)
+		assert.Equal(t, w, next().coef)
+		assert.Equal(t, seq[n].At(1.5), fib.At(n, 1.5))
+	}
+
+	// A leading factor d: 2P_(n+1) = xP_n gives P_n = (x / 2)^n.
+	half := NewRecurrence(NewPolyConst(1), constantFunc(1), constantFunc(0), constantFunc(0), constantFunc(2))
+	assert.Equal(t, []float64{0, 0, 0, 0.125}, half.Nth(3).coef)
+	assert.Equal(t, 0.125, half.At(3, 1))
+}
+
+func Test_RecurrenceFamilies(t *testing.T) {
+	testCases := []struct {
+		name string
+		r    Recurrence
+		p    func(n int) Poly
+	}{
+		{name: "Chebyshev1", r: NewRecurrenceChebyshev1(), p: NewPolyChebyshev1},
+		{name: "Chebyshev2", r: NewRecurrenceChebyshev2(), p: NewPolyChebyshev2},
+		{name: "Legendre", r: NewRecurrenceLegendre(), p: NewPolyLegendre},
+		{name: "Laguerre", r: NewRecurrenceLaguerreAssoc(0), p: NewPolyLaguerre},
+		{name: "Hermite", r: NewRecurrenceHermite(), p: NewPolyHermite},
+		{name: "HermiteE", r: NewRecurrenceHermiteE(), p: NewPolyHermiteE},
+		{
+			name: "Gegenbauer",
+			r:    NewRecurrenceGegenbauer(1.5),
+			p:    func(n int) Poly { return NewPolyGegenbauer(n, 1.5) },
+		},
+		{
+			name: "Jacobi",
+			r:    NewRecurrenceJacobi(0.5, -0.5),
+			p:    func(n int) Poly { return NewPolyJacobi(n, 0.5, -0.5) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seq := tc.r.Sequence(15)
+
+			for n, p := range seq {
+				assert.Equal(t, tc.p(n).coef, p.coef)
+				assert.InDelta(t, p.At(0.3), tc.r.At(n, 0.3), 1e-9*math.Max(1, math.Abs(p.At(0.3))))
+			}
+		})
+	}
+
+	// T_n(cos(theta)) = cos(n theta) well beyond where the coefficients are usable.
+	assert.InDelta(t, math.Cos(1000*0.7), NewRecurrenceChebyshev1().At(1000, math.Cos(0.7)), 1e-10)
+}
+
+func Test_RecurrenceHighDegree(t *testing.T) {
+
+	// n! overflows a float64 past n = 170, so the scaled recurrence must give way.
+	lgamma := func(x float64) float64 {
+		v, _ := math.Lgamma(x)
+		return v
+	}
+
+	testCases := []struct {
+		name string
+		p    Poly
+		n    int
+		lead float64
+	}{
+		{
+			name: "Legendre",
+			p:    NewPolyLegendre(160),
+			n:    160,
+			lead: math.Exp(lgamma(321) - 160*math.Ln2 - 2*lgamma(161)),
+		},
+		{
+			name: "Gegenbauer",
+			p:    NewPolyGegenbauer(171, 1.5),
+			n:    171,
+			lead: math.Exp(171*math.Ln2 + lgamma(172.5) - lgamma(172) - lgamma(1.5)),
+		},
+		{
+			name: "Laguerre",
+			p:    NewPolyLaguerre(171),
+			n:    171,
+			lead: -math.Exp(-lgamma(172)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.n, tc.p.Degree())
+			for _, c := range tc.p.coef {
+				assert.False(t, math.IsNaN(c) || math.IsInf(c, 0))
+			}
+			assert.InEpsilon(t, tc.lead, tc.p.LeadingCoefficient(), 1e-9)
+		})
+	}
+
+	assert.False(t, math.IsNaN(NewPolyLegendre(160).At(0.5)))
+}
@@ -0,0 +1,230 @@
+package polygo
+
+import (
+	"log"
+	"math"
+	"math/big"
+)
+
+// ResultantSylvester returns the resultant of p and q, computed as the determinant of their
+// Sylvester matrix.
+//
+// Let m = deg(p) and n = deg(q). The Sylvester matrix is the (m + n) by (m + n) matrix whose first
+// n rows hold the coefficients of p and whose last m rows hold those of q, each row shifted one
+// place to the right of the one above. The resultant vanishes if and only if p and q have a common
+// root (or both leading coefficients vanish).
+//
+// If p or q is zero, 0 is returned. If both are constant, 1 is returned.
+func (p Poly) ResultantSylvester(q Poly) float64 {
+
+	if p.IsZero() || q.IsZero() {
+		return 0
+	}
+
+	m, n := p.deg, q.deg
+	syl := newMatrix(m+n, m+n)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j <= m; j++ {
+			syl[i][i+j] = p.coef[m-j]
+		}
+	}
+
+	for i := 0; i < m; i++ {
+		for j := 0; j <= n; j++ {
+			syl[n+i][i+j] = q.coef[n-j]
+		}
+	}
+
+	return determinant(syl)
+}
+
+// resultantTolerance bounds the coefficients, relative to the largest coefficient of the dividend
+// and divisor, of a remainder that Poly.Resultant() takes to be zero.
+const resultantTolerance = 1e-12
+
+// Resultant returns the resultant of p and q.
+//
+// The subresultant pseudo-remainder sequence is followed, as in RatPoly.Resultant(). This takes
+// O(mn) time, where m = deg(p) and n = deg(q), compared with O((m + n)^3) for
+// ResultantSylvester(). If p and q have integer coefficients, so does every polynomial in the
+// sequence, whose coefficients stay far smaller than with a plain Euclidean remainder sequence.
+// The computation is still carried out in floating point, so leading coefficients of a remainder
+// within about 1e-12 of the largest coefficient of its dividend and divisor are taken to be zero.
+// Use ResultantExact() when p and q have integer or rational coefficients.
+//
+// If p or q is zero, 0 is returned. If both are constant, 1 is returned.
+func (p Poly) Resultant(q Poly) float64 {
+
+	if p.IsZero() || q.IsZero() {
+		return 0
+	}
+
+	a, b := p, q
+	s := 1.0
+
+	// res(p, q) = (-1)^(mn) res(q, p).
+	if a.deg < b.deg {
+		a, b = b, a
+		if a.deg%2 == 1 && b.deg%2 == 1 {
+			s = -s
+		}
+	}
+
+	g, h := 1.0, 1.0
+
+	for b.deg > 0 {
+		delta := a.deg - b.deg
+
+		if a.deg%2 == 1 && b.deg%2 == 1 {
+			s = -s
+		}
+
+		// The pseudo-remainder lc(b)^(delta + 1) r avoids fractions for integer coefficients. Leading
+		// coefficients left over from cancellation are removed, as the degrees of the sequence
+		// would be wrong otherwise.
+		_, r := a.Div(b)
+		r = r.chop(resultantTolerance * math.Max(maxAbs(a.coef), maxAbs(b.coef)))
+		r = r.MulScalar(math.Pow(b.coef[b.deg], float64(delta+1)))
+
+		a = b
+		b = divScalar(r, g*math.Pow(h, float64(delta)))
+
+		g = a.coef[a.deg]
+		h = math.Pow(h, float64(1-delta)) * math.Pow(g, float64(delta))
+
+		if b.IsZero() {
+			return 0
+		}
+	}
+
+	return s * math.Pow(h, float64(1-a.deg)) * math.Pow(b.coef[0], float64(a.deg))
+}
+
+// ResultantExact returns the resultant of p and q, computed exactly on the rational values of
+// their coefficients (see RatPoly.Resultant()).
+//
+// If p and q have integer coefficients, so does every intermediate polynomial, and the result is
+// an integer.
+func (p Poly) ResultantExact(q Poly) *big.Rat {
+
+	return NewRatPolyFromPoly(p).Resultant(NewRatPolyFromPoly(q))
+}
+
+// Discriminant returns the discriminant of p,
+//
+//   - disc(p) = (-1)^(n(n - 1)/2) res(p, p') / lc(p),
+//
+// where n = deg(p). The discriminant vanishes if and only if p has a repeated root. For example,
+// the discriminant of ax^2 + bx + c is b^2 - 4ac.
+//
+// The resultant is computed with Resultant(). See DiscriminantExact() for an exact version.
+//
+// Panics for constant p.
+func (p Poly) Discriminant() float64 {
+
+	if p.deg == 0 {
+		log.Panic("Discriminant: constant polynomial.")
+	}
+
+	disc := p.Resultant(p.Derivative()) / p.coef[p.deg]
+
+	if (p.deg*(p.deg-1)/2)%2 == 1 {
+		disc = -disc
+	}
+
+	return disc
+}
+
+// DiscriminantExact returns the discriminant of p, computed exactly on the rational values of its
+// coefficients (see RatPoly.Discriminant()).
+//
+// Panics for constant p.
+func (p Poly) DiscriminantExact() *big.Rat {
+
+	if p.deg == 0 {
+		log.Panic("DiscriminantExact: constant polynomial.")
+	}
+
+	return NewRatPolyFromPoly(p).Discriminant()
+}
+
+// Resultant returns the resultant of p and q.
+//
+// The subresultant pseudo-remainder sequence is used, which divides out the extraneous factors
+// introduced by pseudo-division at each step. If p and q have integer coefficients, every
+// intermediate polynomial has integer coefficients of moderate size, and so does the result.
+//
+// If p or q is zero, 0 is returned. If both are constant, 1 is returned.
+func (p RatPoly) Resultant(q RatPoly) *big.Rat {
+
+	if p.IsZero() || q.IsZero() {
+		return new(big.Rat)
+	}
+
+	a, b := p, q
+	s := 1
+
+	// res(p, q) = (-1)^(mn) res(q, p).
+	if a.deg < b.deg {
+		a, b = b, a
+		if a.deg%2 == 1 && b.deg%2 == 1 {
+			s = -s
+		}
+	}
+
+	g, h := big.NewRat(1, 1), big.NewRat(1, 1)
+
+	for b.deg > 0 {
+		delta := a.deg - b.deg
+
+		if a.deg%2 == 1 && b.deg%2 == 1 {
+			s = -s
+		}
+
+		// The pseudo-remainder lc(b)^(delta + 1) r avoids fractions for integer coefficients.
+		_, r := a.Div(b)
+		r = r.MulScalar(powRat(b.coef[b.deg], delta+1))
+
+		a = b
+		b = r.MulScalar(new(big.Rat).Inv(new(big.Rat).Mul(g, powRat(h, delta))))
+
+		g = a.LeadingCoefficient()
+		h = new(big.Rat).Mul(powRat(h, 1-delta), powRat(g, delta))
+
+		if b.IsZero() {
+			return new(big.Rat)
+		}
+	}
+
+	res := new(big.Rat).Mul(powRat(h, 1-a.deg), powRat(b.coef[0], a.deg))
+
+	if s < 0 {
+		res.Neg(res)
+	}
+
+	return res
+}
+
+// Discriminant returns the discriminant of p,
+//
+//   - disc(p) = (-1)^(n(n - 1)/2) res(p, p') / lc(p),
+//
+// where n = deg(p), which vanishes if and only if p has a repeated root.
+//
+// Panics for constant p.
+func (p RatPoly) Discriminant() *big.Rat {
+
+	if p.deg == 0 {
+		log.Panic("Discriminant: constant polynomial.")
+	}
+
+	disc := p.Resultant(p.Derivative())
+	disc.Quo(disc, p.coef[p.deg])
+
+	if (p.deg*(p.deg-1)/2)%2 == 1 {
+		disc.Neg(disc)
+	}
+
+	return disc
+}
@@ -0,0 +1,134 @@
+package polygo
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in resultant.go.
+*/
+
+func Test_PolyResultant(t *testing.T) {
+	testCases := []struct {
+		name string
+		p, q Poly
+		want float64
+	}{
+		{name: "zero", p: NewPolyZero(), q: NewPolyLinear(1, 2), want: 0},
+		{name: "constants", p: NewPolyConst(3), q: NewPolyConst(5), want: 1},
+		{name: "constant q", p: NewPolyQuadratic(1, 0, 1), q: NewPolyConst(3), want: 9},
+		{name: "constant p", p: NewPolyConst(-2), q: NewPolyCubic(1, 0, 0, 1), want: -8},
+		{name: "linear", p: NewPolyLinear(1, -1), q: NewPolyLinear(1, -3), want: -2},
+		{name: "common root", p: NewPolyQuadratic(1, 0, -1), q: NewPolyLinear(1, -1), want: 0},
+		{name: "quadratic and linear", p: NewPolyQuadratic(1, 0, 1), q: NewPolyLinear(1, -2), want: 5},
+		{name: "linear and quadratic", p: NewPolyLinear(1, -2), q: NewPolyQuadratic(1, 0, 1), want: 5},
+		{name: "odd degrees", p: NewPolyCubic(1, 0, 0, -2), q: NewPolyLinear(1, 0), want: 2},
+		{name: "swapped odd degrees", p: NewPolyLinear(1, 0), q: NewPolyCubic(1, 0, 0, -2), want: -2},
+		{name: "factored", p: NewPolyFactored(2, []float64{1, 2, 3}), q: NewPolyFactored(3, []float64{-1, 4}),
+			// lc(p)^2 lc(q)^3 prod (a_i - b_j).
+			want: 4 * 27 * (2 * -3) * (3 * -2) * (4 * -1)},
+		{name: "non-monic", p: NewPoly([]float64{2, -3, 1, 5}), q: NewPoly([]float64{-1, 0, 4}), want: 275},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, tc.p.ResultantSylvester(tc.q), 1e-9*(1+math.Abs(tc.want)))
+			assert.InDelta(t, tc.want, tc.p.Resultant(tc.q), 1e-9*(1+math.Abs(tc.want)))
+
+			want := new(big.Rat).SetFloat64(tc.want)
+			assert.Equal(t, want.String(), tc.p.ResultantExact(tc.q).String())
+		})
+	}
+}
+
+func Test_PolyResultantDegreeGaps(t *testing.T) {
+
+	// Remainders whose degree drops by more than one exercise the subresultant scaling.
+	pairs := [][2]Poly{
+		{NewPoly([]float64{1, 0, 0, 0, 1, 1}), NewPoly([]float64{3, 0, 0, 2})},
+		{NewPoly([]float64{1, 0, 1, 0, -3, -3, 8, 2, -5}), NewPoly([]float64{3, 0, 5, 0, -4, -9, 21})},
+		{NewPoly([]float64{2, 0, 0, 0, 0, -7}), NewPoly([]float64{1, 0, 4, 0, 0})},
+	}
+
+	for _, pq := range pairs {
+		want, _ := pq[0].ResultantExact(pq[1]).Float64()
+
+		assert.InDelta(t, want, pq[0].Resultant(pq[1]), 1e-9*(1+math.Abs(want)))
+		assert.InDelta(t, want, pq[0].ResultantSylvester(pq[1]), 1e-9*(1+math.Abs(want)))
+	}
+}
+
+func Test_PolyResultantLarge(t *testing.T) {
+
+	// Wilkinson's polynomial shares no root with its derivative, and the exact resultant is a
+	// (very large) integer.
+	w := NewPolyWilkinson()
+	exact := w.ResultantExact(w.Derivative())
+
+	assert.True(t, exact.IsInt())
+	assert.NotEqual(t, 0, exact.Sign())
+
+	// The floating point versions approximate it well at moderate degree.
+	p := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7, 8})
+	dp := p.Derivative()
+
+	f, _ := p.ResultantExact(dp).Float64()
+	assert.InEpsilon(t, f, p.Resultant(dp), 1e-9)
+	assert.InEpsilon(t, f, p.ResultantSylvester(dp), 1e-6)
+
+	// A shared root is detected exactly.
+	q := NewPolyFactored(1, []float64{1, 2, 3, 4, 5, 6, 7})
+	r := NewPolyFactored(1, []float64{7, 8, 9, 10})
+	assert.Equal(t, 0, q.ResultantExact(r).Sign())
+}
+
+func Test_PolyDiscriminantPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewPolyConst(1).Discriminant() })
+	assert.Panics(t, func() { NewPolyConst(1).DiscriminantExact() })
+	assert.Panics(t, func() { NewRatPolyConst(big.NewRat(1, 1)).Discriminant() })
+}
+
+func Test_PolyDiscriminant(t *testing.T) {
+	testCases := []struct {
+		name string
+		p    Poly
+		want float64
+	}{
+		{name: "linear", p: NewPolyLinear(3, 1), want: 1},
+		{name: "quadratic", p: NewPolyQuadratic(2, 3, -5), want: 9 + 40},
+		{name: "double root", p: NewPolyQuadratic(1, -2, 1), want: 0},
+		{name: "complex roots", p: NewPolyQuadratic(1, 0, 1), want: -4},
+		// b^2c^2 - 4ac^3 - 4b^3d - 27a^2d^2 + 18abcd.
+		{name: "cubic", p: NewPolyCubic(1, 2, -1, 3), want: 4 - 4*-1 - 4*8*3 - 27*9 + 18*2*-1*3},
+		{name: "depressed cubic", p: NewPolyCubic(1, 0, -3, 1), want: -4*-27 - 27},
+		{name: "repeated root", p: NewPolyFactored(1, []float64{1, 2, 2, 5}), want: 0},
+		// prod over i < j of (r_i - r_j)^2 for a monic p.
+		{name: "quartic", p: NewPolyFactored(1, []float64{0, 1, 2, 4}), want: 1 * 4 * 16 * 1 * 9 * 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, tc.p.Discriminant(), 1e-9*(1+math.Abs(tc.want)))
+
+			want := new(big.Rat).SetFloat64(tc.want)
+			assert.Equal(t, want.String(), tc.p.DiscriminantExact().String())
+		})
+	}
+}
+
+func Test_RatPolyResultant(t *testing.T) {
+
+	// Non-integer coefficients: res(x/2 - 1/3, x^2 - 1/4) = (1/2)^2 ((2/3)^2 - 1/4).
+	p := NewRatPoly([]*big.Rat{big.NewRat(1, 2), big.NewRat(-1, 3)})
+	q := NewRatPoly([]*big.Rat{big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(-1, 4)})
+
+	assert.Equal(t, "7/144", p.Resultant(q).String())
+	assert.Equal(t, "7/144", q.Resultant(p).String())
+	assert.Equal(t, "1/1", q.Discriminant().String())
+	assert.Equal(t, "0/1", p.Resultant(NewRatPolyZero()).String())
+}
@@ -3,6 +3,7 @@ package polygo
 import (
 	"log"
 	"math"
+	"math/big"
 	"math/rand"
 )
 
@@ -120,17 +121,42 @@ const (
 	// (a, b].
 	ALG_SEARCH_NEWTON SearchAlgorithm = iota
 	ALG_SEARCH_BISECT
+
+	// Later algorithms follow in the order they were added, so that no value ever changes.
+	ALG_SEARCH_ABERTH SearchAlgorithm = iota
+	ALG_SEARCH_DURAND_KERNER
+
+	ALG_COUNT_STURM_EXACT CountAlgorithm = iota
+
+	ALG_ISOLATE_BERNSTEIN IsolateAlgorithm = iota
+
+	ALG_SEARCH_EIGEN SearchAlgorithm = iota
+
+	ALG_COUNT_DESCARTES CountAlgorithm = iota
+
+	ALG_ISOLATE_VCA IsolateAlgorithm = iota
+	ALG_ISOLATE_CF
+
+	ALG_COUNT_BUDAN_FOURIER CountAlgorithm = iota
 )
 
 var (
-	newtonIterations = 500
-	bisectPrecision  = 1e-6
+	newtonIterations  = 500
+	bisectPrecision   = 1e-6
+	complexIterations = 500
+	complexTolerance  = 1e-12
 )
 
 func (a CountAlgorithm) String() string {
 	switch a {
 	case ALG_COUNT_STURM:
 		return "ALG_COUNT_STURM"
+	case ALG_COUNT_STURM_EXACT:
+		return "ALG_COUNT_STURM_EXACT"
+	case ALG_COUNT_DESCARTES:
+		return "ALG_COUNT_DESCARTES"
+	case ALG_COUNT_BUDAN_FOURIER:
+		return "ALG_COUNT_BUDAN_FOURIER"
 	}
 	return "ALG_COUNT_UNKNOWN"
 }
@@ -139,6 +165,12 @@ func (a IsolateAlgorithm) String() string {
 	switch a {
 	case ALG_ISOLATE_BISECT:
 		return "ALG_ISOLATE_BISECT"
+	case ALG_ISOLATE_BERNSTEIN:
+		return "ALG_ISOLATE_BERNSTEIN"
+	case ALG_ISOLATE_VCA:
+		return "ALG_ISOLATE_VCA"
+	case ALG_ISOLATE_CF:
+		return "ALG_ISOLATE_CF"
 	}
 	return "ALG_ISOLATE_UNKNOWN"
 }
@@ -149,6 +181,12 @@ func (a SearchAlgorithm) String() string {
 		return "ALG_SEARCH_NEWTON"
 	case ALG_SEARCH_BISECT:
 		return "ALG_SEARCH_BISECT"
+	case ALG_SEARCH_ABERTH:
+		return "ALG_SEARCH_ABERTH"
+	case ALG_SEARCH_DURAND_KERNER:
+		return "ALG_SEARCH_DURAND_KERNER"
+	case ALG_SEARCH_EIGEN:
+		return "ALG_SEARCH_EIGEN"
 	}
 	return "ALG_SEARCH_UNKNOWN"
 }
@@ -165,7 +203,9 @@ type Solver struct {
 	searcher SearchAlgorithm
 
 	// Optional attributes (depends on algorithms used).
-	chainCache map[uint32]sturmChain
+	chainCache    map[uint32]sturmChain
+	ratChainCache map[string]ratSturmChain
+	derivCache    map[string][]Poly
 }
 
 // NewSolver returns a Solver equipped with the given root counting, isolation, and searching
@@ -173,10 +213,12 @@ type Solver struct {
 func NewSolver(counter CountAlgorithm, isolator IsolateAlgorithm, searcher SearchAlgorithm) Solver {
 
 	return Solver{
-		counter:    counter,
-		isolator:   isolator,
-		searcher:   searcher,
-		chainCache: make(map[uint32]sturmChain),
+		counter:       counter,
+		isolator:      isolator,
+		searcher:      searcher,
+		chainCache:    make(map[uint32]sturmChain),
+		ratChainCache: make(map[string]ratSturmChain),
+		derivCache:    make(map[string][]Poly),
 	}
 }
 
@@ -208,6 +250,47 @@ func (s Solver) cacheSturmChain(p Poly) sturmChain {
 	return cache[id]
 }
 
+func (s Solver) cacheRatSturmChain(p Poly) ratSturmChain {
+
+	id := p.exactId()
+	cache := s.ratChainCache
+
+	if chain, ok := cache[id]; ok {
+		return chain
+	}
+
+	// Exact Sturm chain has not been cached.
+	cache[id] = new_ratSturmChain(NewRatPolyFromPoly(p))
+
+	return cache[id]
+}
+
+func (s Solver) cacheDerivatives(p Poly) []Poly {
+
+	id := p.exactId()
+	cache := s.derivCache
+
+	if derivs, ok := cache[id]; ok {
+		return derivs
+	}
+
+	// Derivatives have not been cached.
+	cache[id] = derivatives(p)
+
+	return cache[id]
+}
+
+// CountRootsWithin returns the number of distinct roots of p on the half-open interval (a, b].
+//
+// With ALG_COUNT_STURM_EXACT, p is converted losslessly to a RatPoly and the count is certified.
+//
+// With ALG_COUNT_DESCARTES, Descartes' rule of signs is applied exactly to the square-free part of
+// p mapped onto (a, b). A bound of 0 or 1 is the count. Otherwise, the roots are isolated with the
+// Vincent-Collins-Akritas algorithm (see ALG_ISOLATE_VCA) and counted. No Sturm chain is built.
+//
+// With ALG_COUNT_BUDAN_FOURIER, the Budan-Fourier bound (see Poly.CountBudanFourier()) is tried
+// first. It is cheap to evaluate and exact when it is 0 or 1, which prunes most intervals met
+// during isolation. Otherwise, the roots are counted with ALG_COUNT_STURM.
 func (s Solver) CountRootsWithin(p Poly, a, b float64) int {
 
 	var ret int
@@ -216,6 +299,21 @@ func (s Solver) CountRootsWithin(p Poly, a, b float64) int {
 
 	case ALG_COUNT_STURM:
 		ret = s.cacheSturmChain(p).count(a, b)
+
+	case ALG_COUNT_STURM_EXACT:
+		ret = s.cacheRatSturmChain(p).count(new(big.Rat).SetFloat64(a), new(big.Rat).SetFloat64(b))
+
+	case ALG_COUNT_DESCARTES:
+		ret = vca_count(p, a, b)
+
+	case ALG_COUNT_BUDAN_FOURIER:
+		ret = budan_fourier(s.cacheDerivatives(p), a, b)
+
+		// The bound exceeds the count by an even number, and non-real roots keep it from dropping
+		// below 2 on some arbitrarily small intervals.
+		if ret > 1 {
+			ret = s.cacheSturmChain(p).count(a, b)
+		}
 	}
 
 	return ret
@@ -223,6 +321,19 @@ func (s Solver) CountRootsWithin(p Poly, a, b float64) int {
 
 // IsolateRoots returns a partition of the half-open interval (a, b] such that each half-open
 // subinterval of the partition contains exactly one root of p.
+//
+// With ALG_ISOLATE_BERNSTEIN, the square-free part of p is bisected until its coefficients in the
+// Bernstein basis on each piece have at most one sign change (see BernsteinPoly.IsolateRoots()).
+// The signs of the coefficients are computed exactly, which keeps them reliable on wide intervals.
+//
+// With ALG_ISOLATE_VCA, the square-free part of p is bisected until Descartes' rule of signs,
+// applied to Taylor-shifted reciprocals, certifies at most one root on each piece. The arithmetic
+// is carried out exactly on integers of arbitrary size.
+//
+// With ALG_ISOLATE_CF, the continued fraction method is used (see Poly.IsolateRootsCF()), and the
+// exact rational end points are rounded outwards to float64 (see RatInterval.HalfOpenInterval()).
+// No root is lost, but neighbouring intervals may then overlap by one unit in the last place, so
+// only the RatInterval form is certified.
 func (s Solver) IsolateRootsWithin(p Poly, a, b float64) []HalfOpenInterval {
 
 	partition := []HalfOpenInterval{}
@@ -244,6 +355,27 @@ func (s Solver) IsolateRootsWithin(p Poly, a, b float64) []HalfOpenInterval {
 		m := 0.5 * (a + b)
 
 		partition = append(s.IsolateRootsWithin(p, a, m), s.IsolateRootsWithin(p, m, b)...)
+
+	case ALG_ISOLATE_BERNSTEIN:
+
+		// Bisection guided by sign variations, without counting roots at each step.
+		if a < b && !p.IsZero() {
+			partition = bernstein_isolate(squareFreePart(p), a, b, 1e-12*(b-a))
+		}
+
+	case ALG_ISOLATE_VCA:
+
+		if !p.IsZero() {
+			partition = vca_isolate(p, a, b)
+		}
+
+	case ALG_ISOLATE_CF:
+
+		if !p.IsZero() {
+			for _, h := range p.IsolateRootsCF(a, b) {
+				partition = append(partition, h.HalfOpenInterval())
+			}
+		}
 	}
 
 	return partition
@@ -363,6 +495,20 @@ func (s Solver) FindRootsWithin(p Poly, a, b float64) []float64 {
 		for _, h := range intervals {
 			roots = append(roots, solve_bisect(p, h.L, h.R, s.CountRootsWithin))
 		}
+
+	case ALG_SEARCH_ABERTH, ALG_SEARCH_DURAND_KERNER, ALG_SEARCH_EIGEN:
+
+		// Compute the whole spectrum once and pick out the real root in each interval.
+		croots := s.FindComplexRoots(p)
+
+		for _, h := range intervals {
+			if root, ok := nearest_real_root(croots, h.L, h.R); ok {
+				roots = append(roots, root)
+			} else {
+				// The iteration did not converge well enough, so fall back to bisection.
+				roots = append(roots, solve_bisect(p, h.L, h.R, s.CountRootsWithin))
+			}
+		}
 	}
 
 	return roots
@@ -0,0 +1,361 @@
+package polygo
+
+import (
+	"log"
+	"math"
+	"math/big"
+	"math/cmplx"
+)
+
+// atComplex returns the value of p evaluated at the complex number z.
+func (p Poly) atComplex(z complex128) complex128 {
+
+	// Implement Horner's scheme.
+	out := complex(p.coef[p.deg], 0)
+	for i := p.deg - 1; i >= 0; i-- {
+		out = out*z + complex(p.coef[i], 0)
+	}
+
+	return out
+}
+
+// atComplexWithDerivative returns the values of p and p' evaluated at the complex number z.
+func (p Poly) atComplexWithDerivative(z complex128) (complex128, complex128) {
+
+	// Horner's scheme, carrying the derivative along with the value.
+	out := complex(p.coef[p.deg], 0)
+	dout := complex(0, 0)
+
+	for i := p.deg - 1; i >= 0; i-- {
+		dout = dout*z + out
+		out = out*z + complex(p.coef[i], 0)
+	}
+
+	return out, dout
+}
+
+// initial_complex_guesses returns n distinct starting points on the circle whose radius is the
+// geometric mean |p(0) / lc(p)|^(1 / n) of the root magnitudes, or CauchyBound if p(0) = 0.
+//
+// The Cauchy bound may exceed the largest root by many orders of magnitude (about 1e19 for
+// Wilkinson's polynomial), which leaves the iterations far from converging.
+func initial_complex_guesses(p Poly) []complex128 {
+
+	n := p.deg
+	r := math.Pow(math.Abs(p.coef[0]/p.coef[n]), 1/float64(n))
+	if r == 0 || math.IsInf(r, 0) || math.IsNaN(r) {
+		r = p.CauchyBound()
+	}
+
+	// The angular offset breaks the symmetry with respect to the real axis. Without it, real
+	// polynomials may keep conjugate pairs of guesses stuck on the real line.
+	guesses := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		guesses[k] = cmplx.Rect(r, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+
+	return guesses
+}
+
+// polishIterations bounds the number of Newton steps taken on each root by polish_complex(), and
+// polishPrecision is the precision of the residuals it computes.
+const (
+	polishIterations = 10
+	polishPrecision  = 256
+)
+
+// converged returns true if the step delta applied to z is within the complex search tolerance.
+func converged(z, delta complex128) bool {
+
+	return cmplx.Abs(delta) <= complexTolerance*math.Max(1, cmplx.Abs(z))
+}
+
+// atComplexPrecise returns the value of p evaluated at the complex number z, computed with
+// polishPrecision bits of precision before rounding.
+//
+// Near a root, the terms of p(z) cancel, and Horner's scheme in float64 is left with rounding
+// errors that may be far larger than p(z) itself.
+func (p Poly) atComplexPrecise(z complex128) complex128 {
+
+	newFloat := func(v float64) *big.Float {
+		return new(big.Float).SetPrec(polishPrecision).SetFloat64(v)
+	}
+
+	x, y := newFloat(real(z)), newFloat(imag(z))
+	re, im := newFloat(p.coef[p.deg]), newFloat(0)
+
+	t1, t2 := newFloat(0), newFloat(0)
+
+	for i := p.deg - 1; i >= 0; i-- {
+		// (re + i im)(x + i y) + c.
+		nre := newFloat(p.coef[i])
+		nre.Add(nre, t1.Mul(re, x))
+		nre.Sub(nre, t2.Mul(im, y))
+
+		im.Add(t1.Mul(re, y), t2.Mul(im, x))
+		re = nre
+	}
+
+	r, _ := re.Float64()
+	i, _ := im.Float64()
+
+	return complex(r, i)
+}
+
+// polish_complex refines each approximation in z with Newton's method, for as long as the
+// residual |p(z)| keeps decreasing, and returns z.
+//
+// The simultaneous iterations stop once their steps are small, but the rounding errors in
+// evaluating p in float64 may leave the roots far less accurate than the coefficients of p allow.
+// So, the residual is evaluated with extra precision (see atComplexPrecise()).
+func polish_complex(p Poly, z []complex128) []complex128 {
+
+	dp := p.Derivative()
+
+	for k := range z {
+		pz := p.atComplexPrecise(z[k])
+
+		for iter := 0; iter < polishIterations && pz != 0; iter++ {
+			next := z[k] - pz/dp.atComplex(z[k])
+
+			if cmplx.IsNaN(next) || cmplx.IsInf(next) {
+				break
+			}
+
+			pn := p.atComplexPrecise(next)
+			if cmplx.Abs(pn) >= cmplx.Abs(pz) {
+				break
+			}
+
+			z[k], pz = next, pn
+		}
+	}
+
+	return z
+}
+
+// solve_aberth returns all deg(p) complex roots of p using the Aberth-Ehrlich method.
+func solve_aberth(p Poly) []complex128 {
+
+	z := initial_complex_guesses(p)
+	n := len(z)
+
+	for iter := 0; iter < complexIterations; iter++ {
+
+		done := true
+
+		for k := 0; k < n; k++ {
+
+			pz, dpz := p.atComplexWithDerivative(z[k])
+
+			if pz == 0 {
+				continue
+			}
+
+			// Newton correction.
+			w := pz / dpz
+
+			// Repulsion from the other approximations.
+			var sum complex128
+			for j := 0; j < n; j++ {
+				if j != k {
+					sum += 1 / (z[k] - z[j])
+				}
+			}
+
+			delta := w / (1 - w*sum)
+
+			if cmplx.IsNaN(delta) || cmplx.IsInf(delta) {
+				continue
+			}
+
+			z[k] -= delta
+
+			if !converged(z[k], delta) {
+				done = false
+			}
+		}
+
+		if done {
+			break
+		}
+	}
+
+	return polish_complex(p, z)
+}
+
+// solve_durand_kerner returns all deg(p) complex roots of p using the Durand-Kerner (Weierstrass)
+// method.
+func solve_durand_kerner(p Poly) []complex128 {
+
+	z := initial_complex_guesses(p)
+	n := len(z)
+	lead := complex(p.coef[p.deg], 0)
+
+	for iter := 0; iter < complexIterations; iter++ {
+
+		done := true
+
+		for k := 0; k < n; k++ {
+
+			pz := p.atComplex(z[k])
+
+			if pz == 0 {
+				continue
+			}
+
+			denom := lead
+			for j := 0; j < n; j++ {
+				if j != k {
+					denom *= z[k] - z[j]
+				}
+			}
+
+			delta := pz / denom
+
+			if cmplx.IsNaN(delta) || cmplx.IsInf(delta) {
+				continue
+			}
+
+			z[k] -= delta
+
+			if !converged(z[k], delta) {
+				done = false
+			}
+		}
+
+		if done {
+			break
+		}
+	}
+
+	return polish_complex(p, z)
+}
+
+// solve_eigen returns all deg(p) complex roots of p as the eigenvalues of its companion matrix.
+//
+// Roots at zero are split off first. The companion matrix of the remaining monic factor is
+// balanced, and its eigenvalues are computed with the Hessenberg QR algorithm, as it is already in
+// upper Hessenberg form.
+func solve_eigen(p Poly) []complex128 {
+
+	roots := []complex128{}
+
+	k := 0
+	for p.coef[k] == 0 {
+		roots = append(roots, 0)
+		k++
+	}
+
+	n := p.deg - k
+	if n == 0 {
+		return roots
+	}
+
+	// The first row holds the negated coefficients of the monic factor in decreasing degree, and
+	// the subdiagonal holds ones.
+	comp := newMatrix(n, n)
+	for j := 0; j < n; j++ {
+		comp[0][j] = -p.coef[p.deg-1-j] / p.coef[p.deg]
+	}
+	for i := 1; i < n; i++ {
+		comp[i][i-1] = 1
+	}
+
+	balance(comp)
+
+	return append(roots, hessenbergEigen(comp)...)
+}
+
+// realRootTolerance bounds the imaginary part, relative to the magnitude, of a computed complex
+// root that is taken to be real.
+const realRootTolerance = 1e-6
+
+// nearest_real_root returns the real part of the root in roots with the smallest imaginary part
+// whose real part lies on the half-open interval (left, right].
+//
+// Roots whose imaginary part exceeds realRootTolerance times max(1, |z|) are not real, and are
+// skipped. If no root remains, false is returned.
+func nearest_real_root(roots []complex128, left, right float64) (float64, bool) {
+
+	found := false
+	var best complex128
+
+	for _, z := range roots {
+		if math.Abs(imag(z)) > realRootTolerance*math.Max(1, cmplx.Abs(z)) {
+			continue
+		}
+
+		if left < real(z) && real(z) <= right {
+			if !found || math.Abs(imag(z)) < math.Abs(imag(best)) {
+				best = z
+				found = true
+			}
+		}
+	}
+
+	return real(best), found
+}
+
+// FindComplexRoots returns all deg(p) complex roots of p, repeated according to multiplicity.
+//
+// If the solver is equipped with ALG_SEARCH_EIGEN, the roots are computed as the eigenvalues of the
+// balanced companion matrix of p, like numpy.roots. Otherwise, the roots are searched for
+// simultaneously, with ALG_SEARCH_DURAND_KERNER if the solver is equipped with it, and
+// ALG_SEARCH_ABERTH if not.
+//
+// The eigenvalues are the exact roots of a polynomial within a few rounding errors of p, so a root
+// is accurate to about 1e-16 times its condition number. For Wilkinson's polynomial, this leaves
+// errors of up to about 1e-2. The simultaneous searches finish with Newton steps on residuals
+// computed with extra precision, which brings each simple root to within a few units in the last
+// place of a root of p. Multiple roots are only accurate to about 1e-16^(1/m) for multiplicity m.
+//
+// Panics for infinite solutions.
+func (s Solver) FindComplexRoots(p Poly) []complex128 {
+
+	if p.deg == 0 {
+		if p.coef[0] == 0 {
+			log.Panicf("FindComplexRoots: infinite solutions for %v.", p)
+		}
+		return []complex128{}
+	}
+
+	if p.deg == 1 {
+		return []complex128{complex(solve_linear(p)[0], 0)}
+	}
+
+	switch s.searcher {
+
+	case ALG_SEARCH_DURAND_KERNER:
+		return solve_durand_kerner(p)
+
+	case ALG_SEARCH_EIGEN:
+		return solve_eigen(p)
+	}
+
+	return solve_aberth(p)
+}
+
+// SetComplexSearchIterations sets the maximum number of iterations of the complex root search
+// algorithms to v.
+//
+// Panics for negative v.
+func SetComplexSearchIterations(v int) {
+	if v < 0 {
+		log.Panic("SetComplexSearchIterations: negative v.")
+	}
+
+	complexIterations = v
+}
+
+// SetComplexSearchTolerance sets the relative step size at which the complex root search
+// algorithms are considered to have converged to v.
+//
+// Panics for negative v.
+func SetComplexSearchTolerance(v float64) {
+	if v < 0 {
+		log.Panic("SetComplexSearchTolerance: negative v.")
+	}
+
+	complexTolerance = v
+}
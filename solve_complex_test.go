@@ -0,0 +1,211 @@
+package polygo
+
+import (
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sortComplex sorts s by increasing real part, then by increasing imaginary part.
+func sortComplex(s []complex128) {
+	sort.Slice(s, func(i, j int) bool {
+		if !equalAbs(real(s[i]), real(s[j]), 1e-6) {
+			return real(s[i]) < real(s[j])
+		}
+		return imag(s[i]) < imag(s[j])
+	})
+}
+
+func Test_PolyatComplex(t *testing.T) {
+
+	p := NewPoly([]float64{1, 0, 1})
+
+	assert.Equal(t, complex(0, 0), p.atComplex(complex(0, 1)))
+	assert.Equal(t, complex(2, 0), p.atComplex(complex(1, 0)))
+
+	v, d := p.atComplexWithDerivative(complex(0, 1))
+	assert.Equal(t, complex(0, 0), v)
+	assert.Equal(t, complex(0, 2), d)
+}
+
+func Test_SolverFindComplexRootsPanic(t *testing.T) {
+
+	assert.Panics(t, func() { NewSolverDefault().FindComplexRoots(NewPolyZero()) })
+}
+
+func Test_SolverFindComplexRoots(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		argP Poly
+		want []complex128
+	}{
+		{
+			name: "nonzero const",
+			argP: NewPolyConst(4),
+			want: []complex128{},
+		},
+		{
+			name: "linear",
+			argP: NewPolyLinear(2, -1),
+			want: []complex128{0.5},
+		},
+		{
+			name: "conjugate pair",
+			argP: NewPoly([]float64{1, 0, 1}),
+			want: []complex128{-1i, 1i},
+		},
+		{
+			name: "real cubic",
+			argP: NewPolyFactored(2, []float64{3, 1, 2}),
+			want: []complex128{1, 2, 3},
+		},
+		{
+			name: "mixed quartic",
+			argP: NewPoly([]float64{1, -2, 2, -2, 1}), // (x - 1)^2 (x^2 + 1)
+			want: []complex128{-1i, 1i, 1, 1},
+		},
+		{
+			name: "roots at zero",
+			argP: NewPoly([]float64{2, -1, 0, 0}), // x^2 (2x - 1)
+			want: []complex128{0, 0, 0.5},
+		},
+		{
+			name: "roots of unity",
+			argP: NewPoly([]float64{1, 0, 0, 0, 0, 0, -1}),
+			want: []complex128{
+				cmplx.Rect(1, -2*3.141592653589793/3),
+				cmplx.Rect(1, 2*3.141592653589793/3),
+				cmplx.Rect(1, -3.141592653589793/3),
+				cmplx.Rect(1, 3.141592653589793/3),
+				-1,
+				1,
+			},
+		},
+	}
+
+	for _, alg := range []SearchAlgorithm{ALG_SEARCH_ABERTH, ALG_SEARCH_DURAND_KERNER, ALG_SEARCH_EIGEN} {
+		s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BISECT, alg)
+
+		for _, tc := range testCases {
+			t.Run(alg.String()+" "+tc.name, func(t *testing.T) {
+				got := s.FindComplexRoots(tc.argP)
+
+				want := append([]complex128{}, tc.want...)
+				sortComplex(want)
+				sortComplex(got)
+
+				assert.Len(t, got, len(want))
+				for i := range want {
+					assert.InDelta(t, real(want[i]), real(got[i]), 1e-6)
+					assert.InDelta(t, imag(want[i]), imag(got[i]), 1e-6)
+				}
+			})
+		}
+	}
+}
+
+func Test_SolverFindComplexRootsPolish(t *testing.T) {
+
+	// The roots of Wilkinson's polynomial, with its coefficients rounded to float64, differ from
+	// the integers by up to about 5e-4. Without polishing, the search finds them to about 1e-2.
+	p := NewPolyWilkinson()
+
+	want := []float64{}
+	for _, x := range NewSolverDefault().FindBigRoots(NewBigPolyFromPoly(p, 128)) {
+		v, _ := x.Float64()
+		want = append(want, v)
+	}
+
+	for _, alg := range []SearchAlgorithm{ALG_SEARCH_ABERTH, ALG_SEARCH_DURAND_KERNER} {
+		t.Run(alg.String(), func(t *testing.T) {
+			got := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BISECT, alg).FindComplexRoots(p)
+			sortComplex(got)
+
+			assert.Len(t, got, 20)
+			for i, z := range got {
+				assert.InEpsilon(t, want[i], real(z), 1e-12)
+				assert.InDelta(t, 0, imag(z), 1e-12)
+			}
+		})
+	}
+}
+
+func Test_SolverFindComplexRootsEigen(t *testing.T) {
+
+	s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BISECT, ALG_SEARCH_EIGEN)
+
+	// Wilkinson's polynomial is notoriously ill-conditioned, but its roots are still found to a
+	// few digits.
+	got := s.FindComplexRoots(NewPolyWilkinson())
+	sortComplex(got)
+
+	assert.Len(t, got, 20)
+	for i, z := range got {
+		assert.InDelta(t, float64(i+1), real(z), 1e-2)
+		assert.InDelta(t, 0, imag(z), 1e-2)
+	}
+
+	// The roots of x^60 - 1 are well conditioned.
+	coef := make([]float64, 61)
+	coef[0], coef[60] = 1, -1
+
+	got = s.FindComplexRoots(NewPoly(coef))
+
+	assert.Len(t, got, 60)
+	for _, z := range got {
+		assert.InDelta(t, 1, cmplx.Abs(z), 1e-12)
+		assert.InDelta(t, 0, cmplx.Abs(cmplx.Pow(z, 60)-1), 1e-10)
+	}
+
+	// Badly scaled coefficients need balancing: (x - 1e-4)(x - 1)(x - 1e4).
+	got = s.FindComplexRoots(NewPolyFactored(1, []float64{1e-4, 1, 1e4}))
+	sortComplex(got)
+
+	assert.InEpsilon(t, 1e-4, real(got[0]), 1e-10)
+	assert.InEpsilon(t, 1, real(got[1]), 1e-10)
+	assert.InEpsilon(t, 1e4, real(got[2]), 1e-10)
+
+	assert.Equal(t, "ALG_SEARCH_EIGEN", ALG_SEARCH_EIGEN.String())
+}
+
+func Test_SolverFindRootsWithinComplexSearch(t *testing.T) {
+
+	// x(x - 1)(x + 2)(x^2 + 1) has three real roots and a conjugate pair.
+	p := NewPolyFactored(1, []float64{0, 1, -2}).Mul(NewPoly([]float64{1, 0, 1}))
+
+	for _, alg := range []SearchAlgorithm{ALG_SEARCH_ABERTH, ALG_SEARCH_DURAND_KERNER, ALG_SEARCH_EIGEN} {
+		t.Run(alg.String(), func(t *testing.T) {
+			s := NewSolver(ALG_COUNT_STURM, ALG_ISOLATE_BISECT, alg)
+			got := s.FindRootsWithin(p, -3, 3)
+
+			assert.Len(t, got, 3)
+			sum := 0.0
+			for _, x := range got {
+				assert.InDelta(t, 0, p.At(x), 1e-9)
+				sum += x
+			}
+			assert.InDelta(t, -1, sum, 1e-9)
+		})
+	}
+}
+
+func Test_nearest_real_root(t *testing.T) {
+
+	pair := []complex128{complex(0.5, 0.1), complex(0.5, -0.1), complex(3, 1e-12)}
+
+	_, ok := nearest_real_root(pair, 0, 1)
+	assert.False(t, ok)
+
+	x, ok := nearest_real_root(pair, 0, 4)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, x)
+}
+
+func Test_SetComplexSearchPanic(t *testing.T) {
+
+	assert.Panics(t, func() { SetComplexSearchIterations(-1) })
+	assert.Panics(t, func() { SetComplexSearchTolerance(-1) })
+}
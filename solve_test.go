@@ -218,3 +218,12 @@ func Test_PolyCauchyBound(t *testing.T) {
 // 	// b := NewPolyFromString("x^2")
 // 	// t.Log(s.FindIntersections(a, b))
 // }
+
+func Test_AlgorithmValues(t *testing.T) {
+
+	// The original algorithms keep their values as new ones are added.
+	assert.Equal(t, 0, int(ALG_COUNT_STURM))
+	assert.Equal(t, 1, int(ALG_ISOLATE_BISECT))
+	assert.Equal(t, 2, int(ALG_SEARCH_NEWTON))
+	assert.Equal(t, 3, int(ALG_SEARCH_BISECT))
+}
@@ -0,0 +1,122 @@
+package polygo
+
+import (
+	"log"
+	"math"
+	"sort"
+)
+
+var (
+	squareFreeTolerance = 1e-9
+)
+
+// Factor represents a polynomial factor together with the number of times it divides another
+// polynomial.
+type Factor struct {
+	Poly         Poly
+	Multiplicity int
+}
+
+// Root represents a real root together with its multiplicity.
+type Root struct {
+	Value        float64
+	Multiplicity int
+}
+
+// SquareFreeFactorization returns the square-free factorization of p, i.e. pairwise coprime,
+// square-free, monic polynomials f[i].Poly such that
+//
+// p(x) = LeadingCoefficient(p) * f[0].Poly^f[0].Multiplicity * ... * f[n-1].Poly^f[n-1].Multiplicity,
+//
+// ordered by increasing multiplicity. Each root of p is a simple root of exactly one factor, whose
+// multiplicity is that of the root.
+//
+// Yun's algorithm is used. The GCDs it relies on are computed with the tolerance set by
+// SetSquareFreeTolerance() (see Poly.ExtendedGCD() for its meaning).
+//
+// Constant p has no factors.
+func (p Poly) SquareFreeFactorization() []Factor {
+
+	factors := []Factor{}
+
+	if p.deg == 0 {
+		return factors
+	}
+
+	p = p.Monic()
+	delta := squareFreeTolerance * maxAbs(p.coef)
+
+	dp := p.Derivative()
+	a := p.GCD(dp, squareFreeTolerance)
+
+	b, _ := p.Div(a)
+	c, _ := dp.Div(a)
+	d := c.Sub(b.Derivative()).chop(delta)
+
+	for i := 1; b.deg > 0 && i <= p.deg; i++ {
+
+		if d.IsZero() {
+			// b is square-free and all that is left of p.
+			factors = append(factors, Factor{b.Monic(), i})
+			break
+		}
+
+		a = b.GCD(d, squareFreeTolerance)
+
+		if a.deg > 0 {
+			factors = append(factors, Factor{a, i})
+		}
+
+		b, _ = b.Div(a)
+		b = b.chop(delta)
+		c, _ = d.Div(a)
+		d = c.Sub(b.Derivative()).chop(delta)
+	}
+
+	return factors
+}
+
+// FindRootsWithMultiplicity returns the distinct roots of p on the half-open interval (a, b]
+// together with their multiplicities, ordered by increasing value.
+//
+// p is first split with SquareFreeFactorization(), and the roots of each (square-free) factor are
+// then found with FindRootsWithin().
+//
+// Panics for invalid intervals and infinite solutions.
+func (s Solver) FindRootsWithMultiplicity(p Poly, a, b float64) []Root {
+
+	if b < a {
+		log.Panicf("FindRootsWithMultiplicity: invalid interval (%f, %f].", a, b)
+	}
+
+	if p.IsZero() {
+		log.Panicf("FindRootsWithMultiplicity: infinite solutions for %v.", p)
+	}
+
+	roots := []Root{}
+
+	for _, f := range p.SquareFreeFactorization() {
+		for _, x := range s.FindRootsWithin(f.Poly, a, b) {
+
+			// Low degree factors are solved exactly, without regard for the interval.
+			if a < x && x <= b && !math.IsNaN(x) {
+				roots = append(roots, Root{x, f.Multiplicity})
+			}
+		}
+	}
+
+	sort.Slice(roots, func(i, j int) bool { return roots[i].Value < roots[j].Value })
+
+	return roots
+}
+
+// SetSquareFreeTolerance sets the GCD tolerance used by SquareFreeFactorization() to v.
+//
+// Panics for negative v.
+func SetSquareFreeTolerance(v float64) {
+	if v < 0 {
+		log.Panic("SetSquareFreeTolerance: negative v.")
+	}
+
+	squareFreeTolerance = v
+}
@@ -0,0 +1,88 @@
+package polygo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+	Basic white-box tests for functions and methods defined in squarefree.go.
+*/
+
+func Test_PolySquareFreeFactorization(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		arg  Poly
+		want []Factor
+	}{
+		{
+			name: "constant",
+			arg:  NewPolyConst(3),
+			want: []Factor{},
+		},
+		{
+			name: "square-free",
+			arg:  NewPolyFactored(2, []float64{1, 2, 3}),
+			want: []Factor{{NewPolyFactored(1, []float64{1, 2, 3}), 1}},
+		},
+		{
+			name: "cube",
+			arg:  NewPolyFactored(1, []float64{1, 1, 1}),
+			want: []Factor{{NewPolyLinear(1, -1), 3}},
+		},
+		{
+			name: "mixed",
+			arg:  NewPolyFactored(-4, []float64{1, 1, 1, -2, 0.5, 0.5}),
+			want: []Factor{
+				{NewPolyLinear(1, 2), 1},
+				{NewPolyLinear(1, -0.5), 2},
+				{NewPolyLinear(1, -1), 3},
+			},
+		},
+		{
+			name: "complex roots",
+			arg:  NewPoly([]float64{1, 0, 1}).Pow(2).Mul(NewPolyLinear(1, 0)),
+			want: []Factor{{NewPolyLinear(1, 0), 1}, {NewPoly([]float64{1, 0, 1}), 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.arg.SquareFreeFactorization()
+
+			assert.Len(t, got, len(tc.want))
+			for i := range tc.want {
+				assert.Equal(t, tc.want[i].Multiplicity, got[i].Multiplicity)
+				assert.True(t, tc.want[i].Poly.Sub(got[i].Poly).chop(1e-9).IsZero(), "got %v", got[i].Poly)
+			}
+		})
+	}
+}
+
+func Test_SolverFindRootsWithMultiplicityPanic(t *testing.T) {
+
+	s := NewSolverDefault()
+
+	assert.Panics(t, func() { s.FindRootsWithMultiplicity(NewPolyZero(), 0, 1) })
+	assert.Panics(t, func() { s.FindRootsWithMultiplicity(NewPolyLinear(1, 0), 1, 0) })
+	assert.Panics(t, func() { SetSquareFreeTolerance(-1) })
+}
+
+func Test_SolverFindRootsWithMultiplicity(t *testing.T) {
+
+	s := NewSolverDefault()
+
+	// (x - 1)^3 (x + 2) (x - 3)^2 (x - 5)
+	p := NewPolyFactored(1, []float64{1, 1, 1, -2, 3, 3, 5})
+
+	got := s.FindRootsWithMultiplicity(p, -10, 4)
+	want := []Root{{-2, 1}, {1, 3}, {3, 2}}
+
+	assert.Len(t, got, len(want))
+	for i := range want {
+		assert.InDelta(t, want[i].Value, got[i].Value, 1e-5)
+		assert.Equal(t, want[i].Multiplicity, got[i].Multiplicity)
+	}
+}
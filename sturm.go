@@ -1,6 +1,9 @@
 package polygo
 
-import "log"
+import (
+	"log"
+	"math/big"
+)
 
 var (
 	// Stores already-computed Sturm chains during runtime.
@@ -106,3 +109,154 @@ func cacheSturmChain(p Poly) sturmChain {
 
 	return chainCache[id]
 }
+
+// ratSturmChain represents the exact Sturm chain (or sequence) of a RatPoly.
+type ratSturmChain struct {
+	c   []RatPoly
+	len int
+}
+
+// new_ratSturmChain computes the exact Sturm chain of p.
+func new_ratSturmChain(p RatPoly) ratSturmChain {
+
+	if p.deg == 0 {
+		return ratSturmChain{[]RatPoly{p}, 1}
+	}
+
+	chain := []RatPoly{p, p.Derivative()}
+
+	// Since the arithmetic is exact, the chain ends precisely at the last nonzero remainder.
+	for {
+		_, rem := chain[len(chain)-2].Div(chain[len(chain)-1])
+
+		if rem.IsZero() {
+			break
+		}
+
+		chain = append(chain, rem.MulScalar(big.NewRat(-1, 1)))
+	}
+
+	// The last element is gcd(p, p'), which is nonconstant for p with repeated roots. Dividing it
+	// out of the whole chain keeps the counts correct when a or b is itself a repeated root.
+	if g := chain[len(chain)-1]; !g.IsConstant() {
+		for i := range chain {
+			chain[i], _ = chain[i].Div(g)
+		}
+	}
+
+	return ratSturmChain{
+		c:   chain,
+		len: len(chain),
+	}
+}
+
+// variations returns the number of sign changes in the chain evaluated at x, ignoring zeroes.
+func (s ratSturmChain) variations(x *big.Rat) int {
+
+	v := 0
+	prev := 0
+
+	for _, q := range s.c {
+		curr := q.At(x).Sign()
+
+		if curr == 0 {
+			continue
+		}
+
+		if prev != 0 && curr != prev {
+			v++
+		}
+
+		prev = curr
+	}
+
+	return v
+}
+
+// count returns the number of distinct roots on the half-open interval (a, b] for p associated
+// with s.
+//
+// Panics for invalid intervals.
+func (s ratSturmChain) count(a, b *big.Rat) int {
+
+	if a.Cmp(b) > 0 {
+		log.Panicf("count: invalid interval (%s, %s].", a.RatString(), b.RatString())
+	}
+
+	if s.len == 1 {
+		return 0
+	}
+
+	return s.variations(a) - s.variations(b)
+}
+
+// bigSturmChain represents the Sturm chain (or sequence) of a BigPoly.
+type bigSturmChain struct {
+	c   []BigPoly
+	len int
+}
+
+// new_bigSturmChain computes the Sturm chain of p.
+func new_bigSturmChain(p BigPoly) bigSturmChain {
+
+	if p.deg == 0 {
+		return bigSturmChain{[]BigPoly{p}, 1}
+	}
+
+	chain := []BigPoly{p, p.Derivative()}
+	negOne := big.NewFloat(-1)
+
+	for !chain[len(chain)-1].IsConstant() {
+		_, rem := chain[len(chain)-2].Div(chain[len(chain)-1])
+
+		if rem.IsZero() {
+			break
+		}
+
+		chain = append(chain, rem.MulScalar(negOne))
+	}
+
+	return bigSturmChain{
+		c:   chain,
+		len: len(chain),
+	}
+}
+
+// variations returns the number of sign changes in the chain evaluated at x, ignoring zeroes.
+func (s bigSturmChain) variations(x *big.Float) int {
+
+	v := 0
+	prev := 0
+
+	for _, q := range s.c {
+		curr := q.At(x).Sign()
+
+		if curr == 0 {
+			continue
+		}
+
+		if prev != 0 && curr != prev {
+			v++
+		}
+
+		prev = curr
+	}
+
+	return v
+}
+
+// count returns the number of roots on the half-open interval (a, b] for p associated with s.
+//
+// Panics for invalid intervals.
+func (s bigSturmChain) count(a, b *big.Float) int {
+
+	if a.Cmp(b) > 0 {
+		log.Panicf("count: invalid interval (%s, %s].", a.String(), b.String())
+	}
+
+	if s.len == 1 {
+		return 0
+	}
+
+	return s.variations(a) - s.variations(b)
+}
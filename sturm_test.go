@@ -1,6 +1,7 @@
 package polygo
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -99,3 +100,19 @@ func Test_sturmChain_count(t *testing.T) {
 		})
 	}
 }
+
+func Test_ratSturmChain_countPanic(t *testing.T) {
+
+	c := new_ratSturmChain(NewRatPoly(rats(1, 0, -1)))
+	assert.Panics(t, func() { c.count(big.NewRat(1, 1), big.NewRat(0, 1)) })
+}
+
+func Test_new_ratSturmChain(t *testing.T) {
+
+	// Sturm chain of x^3 - x: x^3 - x, 3x^2 - 1, (2/3)x, 1.
+	c := new_ratSturmChain(NewRatPoly(rats(1, 0, -1, 0)))
+
+	assert.Equal(t, 4, c.len)
+	assert.True(t, c.c[2].Equal(NewRatPoly([]*big.Rat{big.NewRat(2, 3), big.NewRat(0, 1)})))
+	assert.True(t, c.c[3].Equal(NewRatPoly(rats(1))))
+}
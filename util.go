@@ -3,6 +3,7 @@ package polygo
 import (
 	"log"
 	"math"
+	"math/big"
 )
 
 const (
@@ -196,3 +197,104 @@ func choose(n, k int) float64 {
 
 	return fact(n) / (fact(k) * fact(n-k))
 }
+
+// removeTrailingZeroesComplex returns a copy of s with all trailing zeroes removed.
+//
+// If the entire slice is filled with 0, []complex128{0} is returned.
+func removeTrailingZeroesComplex(s []complex128) []complex128 {
+	if len(s) == 0 {
+		return s
+	}
+
+	for s[len(s)-1] == 0 && len(s) > 1 {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+// reverseComplex returns a copy of s with reversed order.
+func reverseComplex(s []complex128) []complex128 {
+	ls := len(s)
+
+	ret := make([]complex128, ls)
+
+	for i := 0; i < ls; i++ {
+		ret[i] = s[ls-i-1]
+	}
+
+	return ret
+}
+
+// expandComplex returns s padded with trailing zeroes to reach length n.
+//
+// If n <= len(s), nothing is changed.
+func expandComplex(s []complex128, n int) []complex128 {
+
+	if n <= len(s) {
+		return s
+	}
+
+	expanded := make([]complex128, n)
+	copy(expanded, s)
+
+	return expanded
+}
+
+// maxAbs returns the maximum absolute value in s.
+//
+// Panics for empty s.
+func maxAbs(s []float64) float64 {
+
+	if len(s) == 0 {
+		log.Panic("maxAbs: empty slice.")
+	}
+
+	max := math.Abs(s[0])
+	for _, v := range s[1:] {
+		if a := math.Abs(v); a > max {
+			max = a
+		}
+	}
+
+	return max
+}
+
+// signVariations returns the number of sign changes in s, ignoring zeroes.
+func signVariations(s []float64) int {
+
+	count := 0
+	last := 0.0
+
+	for _, v := range s {
+		if v == 0 {
+			continue
+		}
+
+		if last != 0 && (v > 0) != (last > 0) {
+			count++
+		}
+
+		last = v
+	}
+
+	return count
+}
+
+// powRat returns x^n, for any integer n.
+//
+// Panics if x = 0 and n < 0.
+func powRat(x *big.Rat, n int) *big.Rat {
+
+	if n < 0 {
+		if x.Sign() == 0 {
+			log.Panic("powRat: zero to a negative power.")
+		}
+		return powRat(new(big.Rat).Inv(x), -n)
+	}
+
+	num := new(big.Int).Exp(x.Num(), big.NewInt(int64(n)), nil)
+	den := new(big.Int).Exp(x.Denom(), big.NewInt(int64(n)), nil)
+
+	return new(big.Rat).SetFrac(num, den)
+}
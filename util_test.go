@@ -2,6 +2,7 @@ package polygo
 
 import (
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -456,3 +457,37 @@ func Test_fact(t *testing.T) {
 		})
 	}
 }
+
+func Test_complexSliceHelpers(t *testing.T) {
+
+	assert.Equal(t, []complex128{1i, 2}, removeTrailingZeroesComplex([]complex128{1i, 2, 0, 0}))
+	assert.Equal(t, []complex128{0}, removeTrailingZeroesComplex([]complex128{0, 0}))
+	assert.Equal(t, []complex128{3, 2i, 1}, reverseComplex([]complex128{1, 2i, 3}))
+	assert.Equal(t, []complex128{1i, 0, 0}, expandComplex([]complex128{1i}, 3))
+	assert.Equal(t, []complex128{1i, 2}, expandComplex([]complex128{1i, 2}, 1))
+}
+
+func Test_maxAbs(t *testing.T) {
+
+	assert.Panics(t, func() { maxAbs([]float64{}) })
+	assert.Equal(t, 3.0, maxAbs([]float64{1, -3, 2}))
+	assert.Equal(t, 0.0, maxAbs([]float64{0}))
+}
+
+func Test_signVariations(t *testing.T) {
+
+	assert.Equal(t, 0, signVariations([]float64{}))
+	assert.Equal(t, 0, signVariations([]float64{0, 0}))
+	assert.Equal(t, 0, signVariations([]float64{1, 2, 3}))
+	assert.Equal(t, 1, signVariations([]float64{1, 0, 0, -3}))
+	assert.Equal(t, 3, signVariations([]float64{-1, 2, 0, -1, 0, 4}))
+}
+
+func Test_powRat(t *testing.T) {
+
+	assert.Panics(t, func() { powRat(new(big.Rat), -1) })
+	assert.Equal(t, "1/1", powRat(big.NewRat(2, 3), 0).String())
+	assert.Equal(t, "-8/27", powRat(big.NewRat(-2, 3), 3).String())
+	assert.Equal(t, "9/4", powRat(big.NewRat(-2, 3), -2).String())
+	assert.Equal(t, "0/1", powRat(new(big.Rat), 2).String())
+}